@@ -0,0 +1,185 @@
+package vimeo
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// AlbumsService handles communication with the albums (showcases) related
+// methods of the Vimeo API.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/showcases
+type AlbumsService service
+
+// Connection internal object provides access to a connection of a resource.
+type Connection struct {
+	URI     string   `json:"uri,omitempty"`
+	Options []string `json:"options,omitempty"`
+	Total   int      `json:"total,omitempty"`
+}
+
+// Metadata internal object provides access to the connections of a resource.
+type Metadata struct {
+	Connections map[string]*Connection `json:"connections,omitempty"`
+}
+
+// total returns the total of the named connection, zero if it's missing.
+func (m *Metadata) total(name string) int {
+	if m == nil || m.Connections[name] == nil {
+		return 0
+	}
+	return m.Connections[name].Total
+}
+
+// GetSummary returns the name, total duration and video count of the album
+// without its other fields.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/albums/%7Balbum_id%7D
+func (s *AlbumsService) GetSummary(ctx context.Context, uid string, ab string) (*Album, *Response, error) {
+	ctx = WithFields(ctx, "uri", "name", "link", "duration", "metadata.connections.videos.total")
+	return s.Get(ctx, uid, ab)
+}
+
+// List lists the albums of the user.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/albums
+func (s *AlbumsService) List(ctx context.Context, uid string, opt *ListAlbumOptions) ([]*Album, *Response, error) {
+	return s.client.Users.ListAlbum(ctx, uid, opt)
+}
+
+// Get returns specific album by ID.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/albums/%7Balbum_id%7D
+func (s *AlbumsService) Get(ctx context.Context, uid string, ab string) (*Album, *Response, error) {
+	return s.client.Users.GetAlbum(ctx, uid, ab)
+}
+
+// Create creates a new album.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/albums
+func (s *AlbumsService) Create(ctx context.Context, uid string, r *AlbumRequest) (*Album, *Response, error) {
+	return s.client.Users.CreateAlbum(ctx, uid, r)
+}
+
+// Edit edits specific album by ID.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/albums/%7Balbum_id%7D
+func (s *AlbumsService) Edit(ctx context.Context, uid string, ab string, r *AlbumRequest) (*Album, *Response, error) {
+	return s.client.Users.EditAlbum(ctx, uid, ab, r)
+}
+
+// Delete deletes specific album by ID.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/albums/%7Balbum_id%7D
+func (s *AlbumsService) Delete(ctx context.Context, uid string, ab string) (*Response, error) {
+	return s.client.Users.DeleteAlbum(ctx, uid, ab)
+}
+
+// ListVideo lists the videos of the album.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/albums/%7Balbum_id%7D/videos
+func (s *AlbumsService) ListVideo(ctx context.Context, uid string, ab string, opt *ListVideoOptions) ([]*Video, *Response, error) {
+	return s.client.Users.AlbumListVideo(ctx, uid, ab, opt)
+}
+
+// VideoPage returns the number of the page of the album videos on which the
+// video appears, zero if the video isn't in the album. The page size is taken
+// from opt, which may be nil.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/albums/%7Balbum_id%7D/videos
+func (s *AlbumsService) VideoPage(ctx context.Context, uid string, ab string, vid int, opt *ListVideoOptions) (int, *Response, error) {
+	o := ListVideoOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	o.ContainingURI = fmt.Sprintf("/videos/%d", vid)
+	o.Page = 0
+
+	videos, resp, err := s.ListVideo(ctx, uid, ab, &o)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	for _, v := range videos {
+		if v.GetID() == vid {
+			return resp.Page, resp, nil
+		}
+	}
+
+	return 0, resp, nil
+}
+
+// AddVideo adds specific video by ID to the album.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/albums/%7Balbum_id%7D/videos/%7Bvideo_id%7D
+func (s *AlbumsService) AddVideo(ctx context.Context, uid string, ab string, vid int) (*Response, error) {
+	return s.client.Users.AlbumAddVideo(ctx, uid, ab, vid)
+}
+
+// DeleteVideo removes specific video by ID from the album.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/albums/%7Balbum_id%7D/videos/%7Bvideo_id%7D
+func (s *AlbumsService) DeleteVideo(ctx context.Context, uid string, ab string, vid int) (*Response, error) {
+	return s.client.Users.AlbumDeleteVideo(ctx, uid, ab, vid)
+}
+
+// UploadThumbnail uploads the image as the custom thumbnail of the album and
+// makes it active.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/albums/%7Balbum_id%7D/custom_thumbnails
+func (s *AlbumsService) UploadThumbnail(ctx context.Context, uid string, ab string, img io.Reader) (*Pictures, *Response, error) {
+	u, err := userPath(uid, "albums/%s/custom_thumbnails", ab)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return uploadPictures(ctx, s.client, u, img)
+}
+
+type albumVideoPositionRequest struct {
+	Position int `json:"position"`
+}
+
+// SetVideoOrder sorts the album manually, with the "arranged" sort, and
+// moves each video to its position in vids, the first one on top. The
+// videos must already be in the album.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/albums/%7Balbum_id%7D/videos/%7Bvideo_id%7D
+func (s *AlbumsService) SetVideoOrder(ctx context.Context, uid string, ab string, vids []int) (*Response, error) {
+	_, resp, err := s.Edit(ctx, uid, ab, &AlbumRequest{Sort: "arranged"})
+	if err != nil {
+		return resp, err
+	}
+
+	for i, vid := range vids {
+		u, err := userPath(uid, "albums/%s/videos/%d", ab, vid)
+		if err != nil {
+			return nil, err
+		}
+
+		req, err := s.client.NewRequest("PUT", u, &albumVideoPositionRequest{Position: i + 1})
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err = s.client.Do(ctx, req, nil)
+		if err != nil {
+			return resp, fmt.Errorf("failed to move video %d to position %d: %w", vid, i+1, err)
+		}
+	}
+
+	return resp, nil
+}
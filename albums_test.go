@@ -0,0 +1,342 @@
+package vimeo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestAlbumsService_GetSummary(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/albums/a", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"fields": "uri,name,link,duration,metadata.connections.videos.total",
+		})
+		fmt.Fprint(w, `{"name": "Test", "duration": 11520, "metadata": {"connections": {"videos": {"uri": "/albums/a/videos", "total": 42}}}}`)
+	})
+
+	album, _, err := client.Albums.GetSummary(context.Background(), "1", "a")
+	if err != nil {
+		t.Errorf("Albums.GetSummary returned unexpected error: %v", err)
+	}
+
+	want := &Album{
+		Name:     "Test",
+		Duration: 11520,
+		Metadata: &Metadata{Connections: map[string]*Connection{
+			"videos": {URI: "/albums/a/videos", Total: 42},
+		}},
+	}
+	if !reflect.DeepEqual(album, want) {
+		t.Errorf("Albums.GetSummary returned %+v, want %+v", album, want)
+	}
+
+	if got := album.VideoCount(); got != 42 {
+		t.Errorf("Album.VideoCount returned %d, want 42", got)
+	}
+}
+
+func TestAlbumsService_GetSummary_authenticatedUser(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/albums/a", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name": "Test"}`)
+	})
+
+	album, _, err := client.Albums.GetSummary(context.Background(), "", "a")
+	if err != nil {
+		t.Errorf("Albums.GetSummary returned unexpected error: %v", err)
+	}
+
+	if got := album.VideoCount(); got != 0 {
+		t.Errorf("Album.VideoCount returned %d, want 0", got)
+	}
+}
+
+func TestAlbumsService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/albums", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"page":     "1",
+			"per_page": "2",
+		})
+		fmt.Fprint(w, `{"data": [{"name": "Test", "privacy": {"view": "anybody"}}]}`)
+	})
+
+	opt := &ListAlbumOptions{
+		ListOptions: ListOptions{Page: 1, PerPage: 2},
+	}
+	albums, _, err := client.Albums.List(context.Background(), "1", opt)
+	if err != nil {
+		t.Errorf("Albums.List returned unexpected error: %v", err)
+	}
+
+	want := []*Album{{Name: "Test", Privacy: &Privacy{View: "anybody"}}}
+	if !reflect.DeepEqual(albums, want) {
+		t.Errorf("Albums.List returned %+v, want %+v", albums, want)
+	}
+}
+
+func TestAlbumsService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/albums/a", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name": "Test"}`)
+	})
+
+	album, _, err := client.Albums.Get(context.Background(), "", "a")
+	if err != nil {
+		t.Errorf("Albums.Get returned unexpected error: %v", err)
+	}
+
+	want := &Album{Name: "Test"}
+	if !reflect.DeepEqual(album, want) {
+		t.Errorf("Albums.Get returned %+v, want %+v", album, want)
+	}
+}
+
+func TestAlbumsService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &AlbumRequest{
+		Name: "name",
+	}
+
+	mux.HandleFunc("/users/1/albums", func(w http.ResponseWriter, r *http.Request) {
+		v := &AlbumRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Albums.Create body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"name": "name"}`)
+	})
+
+	album, _, err := client.Albums.Create(context.Background(), "1", input)
+	if err != nil {
+		t.Errorf("Albums.Create returned unexpected error: %v", err)
+	}
+
+	want := &Album{Name: "name"}
+	if !reflect.DeepEqual(album, want) {
+		t.Errorf("Albums.Create returned %+v, want %+v", album, want)
+	}
+}
+
+func TestAlbumsService_Edit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &AlbumRequest{
+		Name: "name",
+	}
+
+	mux.HandleFunc("/users/1/albums/a", func(w http.ResponseWriter, r *http.Request) {
+		v := &AlbumRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PATCH")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Albums.Edit body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"name": "name"}`)
+	})
+
+	album, _, err := client.Albums.Edit(context.Background(), "1", "a", input)
+	if err != nil {
+		t.Errorf("Albums.Edit returned unexpected error: %v", err)
+	}
+
+	want := &Album{Name: "name"}
+	if !reflect.DeepEqual(album, want) {
+		t.Errorf("Albums.Edit returned %+v, want %+v", album, want)
+	}
+}
+
+func TestAlbumsService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/albums/a", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Albums.Delete(context.Background(), "1", "a")
+	if err != nil {
+		t.Errorf("Albums.Delete returned unexpected error: %v", err)
+	}
+}
+
+func TestAlbumsService_ListVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/albums/a/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
+	})
+
+	videos, _, err := client.Albums.ListVideo(context.Background(), "1", "a", nil)
+	if err != nil {
+		t.Errorf("Albums.ListVideo returned unexpected error: %v", err)
+	}
+
+	want := []*Video{{Name: "Test"}}
+	if !reflect.DeepEqual(videos, want) {
+		t.Errorf("Albums.ListVideo returned %+v, want %+v", videos, want)
+	}
+}
+
+func TestAlbumsService_VideoPage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/albums/a/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"containing_uri": "/videos/2", "per_page": "1"})
+		fmt.Fprint(w, `{"page": 2, "data": [{"uri": "/videos/2"}]}`)
+	})
+
+	opt := &ListVideoOptions{ListOptions: ListOptions{Page: 5, PerPage: 1}}
+	page, _, err := client.Albums.VideoPage(context.Background(), "1", "a", 2, opt)
+	if err != nil {
+		t.Errorf("Albums.VideoPage returned unexpected error: %v", err)
+	}
+
+	if page != 2 {
+		t.Errorf("Albums.VideoPage returned %d, want 2", page)
+	}
+	if opt.ContainingURI != "" || opt.Page != 5 {
+		t.Errorf("Albums.VideoPage modified the options: %+v", opt)
+	}
+}
+
+func TestAlbumsService_VideoPage_notInAlbum(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/albums/a/videos", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"page": 1, "data": [{"uri": "/videos/1"}]}`)
+	})
+
+	page, _, err := client.Albums.VideoPage(context.Background(), "", "a", 2, nil)
+	if err != nil {
+		t.Errorf("Albums.VideoPage returned unexpected error: %v", err)
+	}
+
+	if page != 0 {
+		t.Errorf("Albums.VideoPage returned %d, want 0", page)
+	}
+}
+
+func TestAlbumsService_AddVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/albums/a/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+	})
+
+	_, err := client.Albums.AddVideo(context.Background(), "1", "a", 1)
+	if err != nil {
+		t.Errorf("Albums.AddVideo returned unexpected error: %v", err)
+	}
+}
+
+func TestAlbumsService_DeleteVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/albums/a/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Albums.DeleteVideo(context.Background(), "1", "a", 1)
+	if err != nil {
+		t.Errorf("Albums.DeleteVideo returned unexpected error: %v", err)
+	}
+}
+
+func TestAlbumsService_UploadThumbnail(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/albums/1/custom_thumbnails", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprintf(w, `{"uri": "/users/1/albums/1/custom_thumbnails/2", "link": "%s/upload"}`, server.URL)
+	})
+
+	mux.HandleFunc("/upload", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != "image" {
+			t.Errorf("Albums.UploadThumbnail uploaded %q, want %q", body, "image")
+		}
+	})
+
+	mux.HandleFunc("/users/1/albums/1/custom_thumbnails/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		fmt.Fprint(w, `{"uri": "/users/1/albums/1/custom_thumbnails/2", "active": true}`)
+	})
+
+	pictures, _, err := client.Albums.UploadThumbnail(context.Background(), "", "1", strings.NewReader("image"))
+	if err != nil {
+		t.Errorf("Albums.UploadThumbnail returned unexpected error: %v", err)
+	}
+
+	want := &Pictures{URI: "/users/1/albums/1/custom_thumbnails/2", Active: true, Link: fmt.Sprintf("%s/upload", server.URL)}
+	if !reflect.DeepEqual(pictures, want) {
+		t.Errorf("Albums.UploadThumbnail returned %+v, want %+v", pictures, want)
+	}
+}
+
+func TestAlbumsService_SetVideoOrder(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/albums/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		v := &AlbumRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+		if want := (&AlbumRequest{Sort: "arranged"}); !reflect.DeepEqual(v, want) {
+			t.Errorf("Albums.SetVideoOrder body is %+v, want %+v", v, want)
+		}
+		fmt.Fprint(w, `{}`)
+	})
+
+	var got []string
+	mux.HandleFunc("/users/1/albums/1/videos/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		v := &albumVideoPositionRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+		got = append(got, fmt.Sprintf("%s=%d", strings.TrimPrefix(r.URL.Path, "/users/1/albums/1/videos/"), v.Position))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := client.Albums.SetVideoOrder(context.Background(), "1", "1", []int{3, 2}); err != nil {
+		t.Errorf("Albums.SetVideoOrder returned unexpected error: %v", err)
+	}
+
+	if want := []string{"3=1", "2=2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Albums.SetVideoOrder made %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,117 @@
+package vimeo
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// Token represents an access token issued by the Vimeo API.
+type Token struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type,omitempty"`
+	Scope       string `json:"scope,omitempty"`
+	App         *App   `json:"app,omitempty"`
+	User        *User  `json:"user,omitempty"`
+}
+
+type clientCredentialsRequest struct {
+	GrantType string `json:"grant_type"`
+	Scope     string `json:"scope,omitempty"`
+}
+
+// Authenticate performs the client credentials grant and stores the
+// resulting token, which is then sent with every request of the client.
+// Such a token isn't tied to a user, it can only access public data: request
+// the "public" scope (the default), plus "video_files" to read the video
+// files of the app owner. Methods acting on behalf of a user, such as
+// passing the empty string for authenticated user, need a user token.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/authentication#unauthenticated-requests
+func (c *Client) Authenticate(ctx context.Context, clientID, clientSecret string, scopes ...string) (*Token, *Response, error) {
+	if clientID == "" || clientSecret == "" {
+		return nil, nil, errors.New("the client ID and secret are required")
+	}
+
+	body := &clientCredentialsRequest{
+		GrantType: "client_credentials",
+		Scope:     strings.Join(scopes, " "),
+	}
+
+	req, err := c.NewRequest("POST", "oauth/authorize/client", body)
+	if err != nil {
+		return nil, nil, err
+	}
+	// The client credentials replace any token of the client.
+	req.Header.Del("Authorization")
+	req.SetBasicAuth(clientID, clientSecret)
+
+	token := &Token{}
+
+	resp, err := c.Do(ctx, req, token)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if token.AccessToken == "" {
+		return nil, resp, errors.New("the API returned no access token")
+	}
+
+	c.SetToken(token)
+
+	return token, resp, nil
+}
+
+// VerifyResult represents the token checked by Verify.
+type VerifyResult struct {
+	Scopes  []string
+	App     *App
+	UserURI string
+}
+
+// Verify checks the token of the client, returning its scopes, app and
+// user, the latter empty for a token not tied to a user. An expired or
+// invalid token fails with an error matching ErrUnauthorized.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/authentication
+func (c *Client) Verify(ctx context.Context) (*VerifyResult, error) {
+	req, err := c.NewRequest("GET", "oauth/verify", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	token := &Token{}
+
+	_, err = c.Do(ctx, req, token)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &VerifyResult{
+		Scopes: strings.Fields(token.Scope),
+		App:    token.App,
+	}
+	if token.User != nil {
+		result.UserURI = token.User.URI
+	}
+
+	return result, nil
+}
+
+// Token returns the token stored by Authenticate or SetToken, nil if none.
+func (c *Client) Token() *Token {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.token
+}
+
+// SetToken sets the token sent with every request of the client, for example
+// a token persisted from a previous Authenticate call or a personal access
+// token. Passing nil removes the token.
+func (c *Client) SetToken(t *Token) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.token = t
+}
@@ -0,0 +1,121 @@
+package vimeo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestClient_Authenticate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/oauth/authorize/client", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		id, secret, ok := r.BasicAuth()
+		if !ok || id != "id" || secret != "secret" {
+			t.Errorf("Authenticate basic auth is %q:%q, want id:secret", id, secret)
+		}
+
+		v := &clientCredentialsRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+		want := &clientCredentialsRequest{GrantType: "client_credentials", Scope: "public video_files"}
+		if !reflect.DeepEqual(v, want) {
+			t.Errorf("Authenticate body is %+v, want %+v", v, want)
+		}
+
+		fmt.Fprint(w, `{"access_token": "t", "token_type": "bearer", "scope": "public video_files"}`)
+	})
+
+	mux.HandleFunc("/users/1", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Authorization", "Bearer t")
+		fmt.Fprint(w, `{}`)
+	})
+
+	token, _, err := client.Authenticate(context.Background(), "id", "secret", "public", "video_files")
+	if err != nil {
+		t.Fatalf("Authenticate returned unexpected error: %v", err)
+	}
+
+	want := &Token{AccessToken: "t", TokenType: "bearer", Scope: "public video_files"}
+	if !reflect.DeepEqual(token, want) {
+		t.Errorf("Authenticate returned %+v, want %+v", token, want)
+	}
+
+	if client.Token() != token {
+		t.Errorf("Client.Token returned %+v, want %+v", client.Token(), token)
+	}
+
+	if _, _, err := client.Users.Get(context.Background(), "1"); err != nil {
+		t.Errorf("Users.Get returned unexpected error: %v", err)
+	}
+}
+
+func TestClient_Authenticate_missingCredentials(t *testing.T) {
+	c := NewClient(nil)
+
+	if _, _, err := c.Authenticate(context.Background(), "id", ""); err == nil {
+		t.Error("Authenticate expected error")
+	}
+}
+
+func TestClient_SetToken(t *testing.T) {
+	c := NewClient(nil)
+	c.SetToken(&Token{AccessToken: "t"})
+
+	req, _ := c.NewRequest("GET", "me", nil)
+	if got := req.Header.Get("Authorization"); got != "Bearer t" {
+		t.Errorf("NewRequest Authorization header is %q, want %q", got, "Bearer t")
+	}
+
+	c.SetToken(nil)
+
+	req, _ = c.NewRequest("GET", "me", nil)
+	if got := req.Header.Get("Authorization"); got != "" {
+		t.Errorf("NewRequest Authorization header is %q, want empty", got)
+	}
+}
+
+func TestClient_Verify(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/oauth/verify", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"access_token": "t", "scope": "public private", "app": {"uri": "/apps/1", "name": "App"}, "user": {"uri": "/users/1"}}`)
+	})
+
+	result, err := client.Verify(context.Background())
+	if err != nil {
+		t.Fatalf("Verify returned unexpected error: %v", err)
+	}
+
+	want := &VerifyResult{
+		Scopes:  []string{"public", "private"},
+		App:     &App{URI: "/apps/1", Name: "App"},
+		UserURI: "/users/1",
+	}
+	if !reflect.DeepEqual(result, want) {
+		t.Errorf("Verify returned %+v, want %+v", result, want)
+	}
+}
+
+func TestClient_Verify_unauthorized(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/oauth/verify", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"error": "You must provide a valid authenticated access token."}`)
+	})
+
+	_, err := client.Verify(context.Background())
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("Verify returned error %v, want %v", err, ErrUnauthorized)
+	}
+}
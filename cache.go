@@ -0,0 +1,139 @@
+package vimeo
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+// Cache stores the bodies of GET responses with their ETag, set as
+// Client.Cache to make the requests conditional. Get returns an empty etag
+// if key isn't cached. A Cache must be safe for concurrent use.
+type Cache interface {
+	Get(key string) (etag string, body []byte)
+	Set(key, etag string, body []byte)
+}
+
+// LRUCache is an in-memory Cache keeping the most recently used entries.
+type LRUCache struct {
+	mu      sync.Mutex
+	size    int
+	entries *list.List
+	keys    map[string]*list.Element
+}
+
+type lruEntry struct {
+	key  string
+	etag string
+	body []byte
+}
+
+// NewLRUCache returns an LRUCache holding at most size entries, at least one.
+func NewLRUCache(size int) *LRUCache {
+	if size < 1 {
+		size = 1
+	}
+
+	return &LRUCache{
+		size:    size,
+		entries: list.New(),
+		keys:    make(map[string]*list.Element),
+	}
+}
+
+// Get returns the entry of key and marks it as recently used.
+func (c *LRUCache) Get(key string) (string, []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.keys[key]
+	if !ok {
+		return "", nil
+	}
+	c.entries.MoveToFront(e)
+
+	entry := e.Value.(*lruEntry)
+	return entry.etag, entry.body
+}
+
+// Set stores the entry of key, evicting the least recently used entry if the
+// cache is full.
+func (c *LRUCache) Set(key, etag string, body []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.keys[key]; ok {
+		e.Value = &lruEntry{key: key, etag: etag, body: body}
+		c.entries.MoveToFront(e)
+		return
+	}
+
+	c.keys[key] = c.entries.PushFront(&lruEntry{key: key, etag: etag, body: body})
+
+	if c.entries.Len() > c.size {
+		oldest := c.entries.Back()
+		c.entries.Remove(oldest)
+		delete(c.keys, oldest.Value.(*lruEntry).key)
+	}
+}
+
+// cacheKey identifies the response to req. The token is part of it, hashed,
+// since the same URL may return another resource for another user.
+func cacheKey(req *http.Request) string {
+	auth := sha256.Sum256([]byte(req.Header.Get("Authorization")))
+	return fmt.Sprintf("%x %s %s", auth[:8], req.Header.Get("Accept"), req.URL.String())
+}
+
+// cacheRequest makes req conditional if its response is in Client.Cache. It
+// returns the cache key, empty if req isn't cacheable, and the cached body.
+// Only the API responses decoded into v are cached: the uploads, downloads
+// and responses streamed to an io.Writer are never buffered.
+func (c *Client) cacheRequest(req *http.Request, v interface{}) (string, []byte) {
+	if c.Cache == nil || req.Method != "GET" || req.Header.Get("If-None-Match") != "" {
+		return "", nil
+	}
+
+	if _, ok := v.(io.Writer); ok || !c.apiRequest(req) {
+		return "", nil
+	}
+
+	key := cacheKey(req)
+
+	etag, body := c.Cache.Get(key)
+	if etag != "" && body != nil {
+		h := req.Header.Clone()
+		h.Set("If-None-Match", etag)
+		req.Header = h
+	}
+
+	return key, body
+}
+
+// cacheResponse serves the cached body on a 304 Not Modified response, or
+// stores the body of a 200 OK response with an ETag. It reports whether the
+// cached body is served.
+func (c *Client) cacheResponse(key string, cached []byte, resp *http.Response) (bool, error) {
+	switch {
+	case key == "":
+		return false, nil
+
+	case resp.StatusCode == http.StatusNotModified && cached != nil:
+		resp.Body = ioutil.NopCloser(bytes.NewReader(cached))
+		return true, nil
+
+	case resp.StatusCode == http.StatusOK && resp.Header.Get("ETag") != "":
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return false, err
+		}
+		c.Cache.Set(key, resp.Header.Get("ETag"), body)
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	return false, nil
+}
@@ -0,0 +1,154 @@
+package vimeo
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestLRUCache(t *testing.T) {
+	c := NewLRUCache(2)
+
+	c.Set("a", "1", []byte("a"))
+	c.Set("b", "2", []byte("b"))
+	c.Get("a")
+	c.Set("c", "3", []byte("c"))
+
+	if etag, _ := c.Get("b"); etag != "" {
+		t.Errorf("LRUCache kept the least recently used entry, etag %q", etag)
+	}
+
+	for key, want := range map[string]string{"a": "1", "c": "3"} {
+		if etag, body := c.Get(key); etag != want || string(body) != key {
+			t.Errorf("LRUCache.Get(%q) returned %q, %q, want %q, %q", key, etag, body, want, key)
+		}
+	}
+}
+
+func TestDo_cache(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Cache = NewLRUCache(10)
+
+	calls := 0
+	mux.HandleFunc("/users/1", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `{"name": "Test"}`)
+	})
+
+	for i := 0; i < 2; i++ {
+		user, resp, err := client.Users.Get(context.Background(), "1")
+		if err != nil {
+			t.Fatalf("Users.Get returned unexpected error: %v", err)
+		}
+
+		if want := (&User{Name: "Test"}); !reflect.DeepEqual(user, want) {
+			t.Errorf("Users.Get returned %+v, want %+v", user, want)
+		}
+		if want := i == 1; resp.Cached != want {
+			t.Errorf("Users.Get response #%d Cached is %v, want %v", i+1, resp.Cached, want)
+		}
+	}
+
+	if calls != 2 {
+		t.Errorf("Users.Get made %d requests, want 2", calls)
+	}
+}
+
+func TestDo_cacheOtherToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Cache = NewLRUCache(10)
+
+	mux.HandleFunc("/me", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") != "" {
+			t.Error("Do sent If-None-Match for the response of another token")
+		}
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `{"name": "Test"}`)
+	})
+
+	client.SetToken(&Token{AccessToken: "a"})
+	if _, _, err := client.Users.Get(context.Background(), ""); err != nil {
+		t.Fatalf("Users.Get returned unexpected error: %v", err)
+	}
+
+	client.SetToken(&Token{AccessToken: "b"})
+	if _, _, err := client.Users.Get(context.Background(), ""); err != nil {
+		t.Fatalf("Users.Get returned unexpected error: %v", err)
+	}
+}
+
+// recordingCache is a Cache recording the stored keys.
+type recordingCache struct {
+	*LRUCache
+	keys []string
+}
+
+func (c *recordingCache) Set(key, etag string, body []byte) {
+	c.keys = append(c.keys, key)
+	c.LRUCache.Set(key, etag, body)
+}
+
+func TestDo_cacheDownloadTo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	cache := &recordingCache{LRUCache: NewLRUCache(10)}
+	client.Cache = cache
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"download": [{"quality": "hd", "link": "%s/hd"}]}`, server.URL)
+	})
+
+	mux.HandleFunc("/hd", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, "video")
+	})
+
+	var buf bytes.Buffer
+	if _, err := client.Videos.DownloadTo(context.Background(), 1, "hd", &buf); err != nil {
+		t.Fatalf("Videos.DownloadTo returned unexpected error: %v", err)
+	}
+
+	if buf.String() != "video" {
+		t.Errorf("Videos.DownloadTo wrote %q, want %q", buf.String(), "video")
+	}
+	if len(cache.keys) != 0 {
+		t.Errorf("Videos.DownloadTo cached %v, want nothing", cache.keys)
+	}
+}
+
+func TestDo_cacheOtherHost(t *testing.T) {
+	setup()
+	defer teardown()
+
+	cache := &recordingCache{LRUCache: NewLRUCache(10)}
+	client.Cache = cache
+
+	cdn := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `{}`)
+	}))
+	defer cdn.Close()
+
+	req, _ := http.NewRequest("GET", cdn.URL+"/data.json", nil)
+	if _, err := client.Do(context.Background(), req, &struct{}{}); err != nil {
+		t.Fatalf("Do returned unexpected error: %v", err)
+	}
+
+	if len(cache.keys) != 0 {
+		t.Errorf("Do cached %v, want nothing", cache.keys)
+	}
+}
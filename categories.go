@@ -1,6 +1,9 @@
 package vimeo
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // CategoriesService handles communication with the categories related
 // methods of the Vimeo API.
@@ -21,14 +24,14 @@ type Category struct {
 	TopLevel              bool           `json:"top_level"`
 	Pictures              *Pictures      `json:"pictures,omitempty"`
 	LastVideoFeaturedTime string         `json:"last_video_featured_time,omitempty"`
-	Parent                string         `json:"parent,omitempty"`
+	Parent                *SubCategory   `json:"parent,omitempty"`
 	SubCategories         []*SubCategory `json:"subcategories,omitempty"`
 	ResourceKey           string         `json:"resource_key,omitempty"`
 }
 
 // SubCategory internal object provides access to subcategory in category.
 type SubCategory struct {
-	URI  string `json:"URI,omitempty"`
+	URI  string `json:"uri,omitempty"`
 	Name string `json:"name,omitempty"`
 	Link string `json:"link,omitempty"`
 }
@@ -39,7 +42,7 @@ type ListCategoryOptions struct {
 	ListOptions
 }
 
-func listCategory(c *Client, url string, opt *ListCategoryOptions) ([]*Category, *Response, error) {
+func listCategory(ctx context.Context, c *Client, url string, opt *ListCategoryOptions) ([]*Category, *Response, error) {
 	u, err := addOptions(url, opt)
 	if err != nil {
 		return nil, nil, err
@@ -52,7 +55,7 @@ func listCategory(c *Client, url string, opt *ListCategoryOptions) ([]*Category,
 
 	categories := &dataListCategory{}
 
-	resp, err := c.Do(req, categories)
+	resp, err := c.Do(ctx, req, categories)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -62,7 +65,7 @@ func listCategory(c *Client, url string, opt *ListCategoryOptions) ([]*Category,
 	return categories.Data, resp, err
 }
 
-func getCategory(c *Client, url string) (*Category, *Response, error) {
+func getCategory(ctx context.Context, c *Client, url string) (*Category, *Response, error) {
 	req, err := c.NewRequest("GET", url, nil)
 	if err != nil {
 		return nil, nil, err
@@ -70,7 +73,7 @@ func getCategory(c *Client, url string) (*Category, *Response, error) {
 
 	category := &Category{}
 
-	resp, err := c.Do(req, category)
+	resp, err := c.Do(ctx, req, category)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -81,8 +84,8 @@ func getCategory(c *Client, url string) (*Category, *Response, error) {
 // List the category.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/categories
-func (s *CategoriesService) List(opt *ListCategoryOptions) ([]*Category, *Response, error) {
-	categories, resp, err := listCategory(s.client, "categories", opt)
+func (s *CategoriesService) List(ctx context.Context, opt *ListCategoryOptions) ([]*Category, *Response, error) {
+	categories, resp, err := listCategory(ctx, s.client, "categories", opt)
 
 	return categories, resp, err
 }
@@ -90,19 +93,44 @@ func (s *CategoriesService) List(opt *ListCategoryOptions) ([]*Category, *Respon
 // Get specific category by name.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/categories/%7Bcategory%7D
-func (s *CategoriesService) Get(cat string) (*Category, *Response, error) {
+func (s *CategoriesService) Get(ctx context.Context, cat string) (*Category, *Response, error) {
 	u := fmt.Sprintf("categories/%s", cat)
-	category, resp, err := getCategory(s.client, u)
+	category, resp, err := getCategory(ctx, s.client, u)
 
 	return category, resp, err
 }
 
+// ListSubcategories lists the subcategories of an category. Each returned
+// category has Parent set to the requested category.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/categories/%7Bcategory%7D
+func (s *CategoriesService) ListSubcategories(ctx context.Context, cat string) ([]*Category, *Response, error) {
+	category, resp, err := s.Get(ctx, cat)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	parent := &SubCategory{URI: category.URI, Name: category.Name, Link: category.Link}
+
+	categories := make([]*Category, 0, len(category.SubCategories))
+	for _, sub := range category.SubCategories {
+		categories = append(categories, &Category{
+			URI:    sub.URI,
+			Name:   sub.Name,
+			Link:   sub.Link,
+			Parent: parent,
+		})
+	}
+
+	return categories, resp, err
+}
+
 // ListChannel lists the channel for an category.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/categories/%7Bcategory%7D/channels
-func (s *CategoriesService) ListChannel(cat string, opt *ListChannelOptions) ([]*Channel, *Response, error) {
+func (s *CategoriesService) ListChannel(ctx context.Context, cat string, opt *ListChannelOptions) ([]*Channel, *Response, error) {
 	u := fmt.Sprintf("categories/%s/channels", cat)
-	channels, resp, err := listChannel(s.client, u, opt)
+	channels, resp, err := listChannel(ctx, s.client, u, opt)
 
 	return channels, resp, err
 }
@@ -110,9 +138,9 @@ func (s *CategoriesService) ListChannel(cat string, opt *ListChannelOptions) ([]
 // ListGroup lists the group for an category.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/categories/%7Bcategory%7D/groups
-func (s *CategoriesService) ListGroup(cat string, opt *ListGroupOptions) ([]*Group, *Response, error) {
+func (s *CategoriesService) ListGroup(ctx context.Context, cat string, opt *ListGroupOptions) ([]*Group, *Response, error) {
 	u := fmt.Sprintf("categories/%s/groups", cat)
-	groups, resp, err := listGroup(s.client, u, opt)
+	groups, resp, err := listGroup(ctx, s.client, u, opt)
 
 	return groups, resp, err
 }
@@ -120,9 +148,9 @@ func (s *CategoriesService) ListGroup(cat string, opt *ListGroupOptions) ([]*Gro
 // ListVideo lists the video for an category.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/categories/%7Bcategory%7D/videos
-func (s *CategoriesService) ListVideo(cat string, opt *ListVideoOptions) ([]*Video, *Response, error) {
+func (s *CategoriesService) ListVideo(ctx context.Context, cat string, opt *ListVideoOptions) ([]*Video, *Response, error) {
 	u := fmt.Sprintf("categories/%s/videos", cat)
-	videos, resp, err := listVideo(s.client, u, opt)
+	videos, resp, err := listVideo(ctx, s.client, u, opt)
 
 	return videos, resp, err
 }
@@ -130,9 +158,9 @@ func (s *CategoriesService) ListVideo(cat string, opt *ListVideoOptions) ([]*Vid
 // GetVideo specific video by category name and video ID.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/categories/%7Bcategory%7D/videos/%7Bvideo_id%7D
-func (s *CategoriesService) GetVideo(cat string, vid int) (*Video, *Response, error) {
+func (s *CategoriesService) GetVideo(ctx context.Context, cat string, vid int) (*Video, *Response, error) {
 	u := fmt.Sprintf("categories/%s/videos/%d", cat, vid)
-	video, resp, err := getVideo(s.client, u)
+	video, resp, err := getVideo(ctx, s.client, u)
 
 	return video, resp, err
 }
@@ -1,6 +1,7 @@
 package vimeo
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -23,7 +24,7 @@ func TestCategoriesService_List(t *testing.T) {
 	opt := &ListCategoryOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	categories, _, err := client.Categories.List(opt)
+	categories, _, err := client.Categories.List(context.Background(), opt)
 	if err != nil {
 		t.Errorf("Categories.List returned unexpected error: %v", err)
 	}
@@ -43,7 +44,7 @@ func TestCategoriesService_Get(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	category, _, err := client.Categories.Get("cat")
+	category, _, err := client.Categories.Get(context.Background(), "cat")
 	if err != nil {
 		t.Errorf("Categories.Get returned unexpected error: %v", err)
 	}
@@ -54,6 +55,50 @@ func TestCategoriesService_Get(t *testing.T) {
 	}
 }
 
+func TestCategoriesService_Get_subcategories(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/categories/nature", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name": "Nature", "parent": {"uri": "/categories/documentary", "name": "Documentary"}}`)
+	})
+
+	category, _, err := client.Categories.Get(context.Background(), "nature")
+	if err != nil {
+		t.Errorf("Categories.Get returned unexpected error: %v", err)
+	}
+
+	want := &Category{Name: "Nature", Parent: &SubCategory{URI: "/categories/documentary", Name: "Documentary"}}
+	if !reflect.DeepEqual(category, want) {
+		t.Errorf("Categories.Get returned %+v, want %+v", category, want)
+	}
+}
+
+func TestCategoriesService_ListSubcategories(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/categories/documentary", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"uri": "/categories/documentary", "name": "Documentary", "subcategories": [{"uri": "/categories/documentary/nature", "name": "Nature"}]}`)
+	})
+
+	categories, _, err := client.Categories.ListSubcategories(context.Background(), "documentary")
+	if err != nil {
+		t.Errorf("Categories.ListSubcategories returned unexpected error: %v", err)
+	}
+
+	want := []*Category{{
+		URI:    "/categories/documentary/nature",
+		Name:   "Nature",
+		Parent: &SubCategory{URI: "/categories/documentary", Name: "Documentary"},
+	}}
+	if !reflect.DeepEqual(categories, want) {
+		t.Errorf("Categories.ListSubcategories returned %+v, want %+v", categories, want)
+	}
+}
+
 func TestCategoriesService_ListChannel(t *testing.T) {
 	setup()
 	defer teardown()
@@ -70,7 +115,7 @@ func TestCategoriesService_ListChannel(t *testing.T) {
 	opt := &ListChannelOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	channels, _, err := client.Categories.ListChannel("cat", opt)
+	channels, _, err := client.Categories.ListChannel(context.Background(), "cat", opt)
 	if err != nil {
 		t.Errorf("Categories.ListChannel returned unexpected error: %v", err)
 	}
@@ -97,7 +142,7 @@ func TestCategoriesService_ListGroup(t *testing.T) {
 	opt := &ListGroupOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	groups, _, err := client.Categories.ListGroup("cat", opt)
+	groups, _, err := client.Categories.ListGroup(context.Background(), "cat", opt)
 	if err != nil {
 		t.Errorf("Categories.ListGroup returned unexpected error: %v", err)
 	}
@@ -108,6 +153,29 @@ func TestCategoriesService_ListGroup(t *testing.T) {
 	}
 }
 
+func TestCategoriesService_ListGroup_paging(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/categories/cat/groups", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"total": 3, "page": 1, "per_page": 2,
+			"paging": {"next": "/categories/cat/groups?page=2"},
+			"data": [{"name": "A"}, {"name": "B"}]}`)
+	})
+
+	_, resp, err := client.Categories.ListGroup(context.Background(), "cat", nil)
+	if err != nil {
+		t.Fatalf("Categories.ListGroup returned unexpected error: %v", err)
+	}
+
+	if resp.Page != 1 || resp.PerPage != 2 || resp.Total != 3 {
+		t.Errorf("Categories.ListGroup returned page %d, per page %d, total %d, want 1, 2, 3", resp.Page, resp.PerPage, resp.Total)
+	}
+	if want := "/categories/cat/groups?page=2"; resp.NextPage != want {
+		t.Errorf("Categories.ListGroup returned next page %q, want %q", resp.NextPage, want)
+	}
+}
+
 func TestCategoriesService_ListVideo(t *testing.T) {
 	setup()
 	defer teardown()
@@ -124,7 +192,7 @@ func TestCategoriesService_ListVideo(t *testing.T) {
 	opt := &ListVideoOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	videos, _, err := client.Categories.ListVideo("cat", opt)
+	videos, _, err := client.Categories.ListVideo(context.Background(), "cat", opt)
 	if err != nil {
 		t.Errorf("Categories.ListVideo returned unexpected error: %v", err)
 	}
@@ -144,7 +212,7 @@ func TestCategoriesService_GetVideo(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	video, _, err := client.Categories.GetVideo("cat", 1)
+	video, _, err := client.Categories.GetVideo(context.Background(), "cat", 1)
 	if err != nil {
 		t.Errorf("Categories.GetVideo returned unexpected error: %v", err)
 	}
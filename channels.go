@@ -1,9 +1,9 @@
 package vimeo
 
 import (
+	"context"
 	"fmt"
 	"strings"
-	"time"
 )
 
 // ChannelsService handles communication with the channels related
@@ -23,8 +23,8 @@ type Channel struct {
 	Name         string    `json:"name,omitempty"`
 	Description  string    `json:"description,omitempty"`
 	Link         string    `json:"link,omitempty"`
-	CreatedTime  time.Time `json:"created_time,omitempty"`
-	ModifiedTime time.Time `json:"modified_time,omitempty"`
+	CreatedTime  Timestamp `json:"created_time,omitempty"`
+	ModifiedTime Timestamp `json:"modified_time,omitempty"`
 	User         *User     `json:"user,omitempty"`
 	Pictures     *Pictures `json:"pictures,omitempty"`
 	Header       *Header   `json:"header,omitempty"`
@@ -54,7 +54,7 @@ func (c Channel) GetID() string {
 	return id
 }
 
-func listChannel(c *Client, url string, opt *ListChannelOptions) ([]*Channel, *Response, error) {
+func listChannel(ctx context.Context, c *Client, url string, opt *ListChannelOptions) ([]*Channel, *Response, error) {
 	u, err := addOptions(url, opt)
 	if err != nil {
 		return nil, nil, err
@@ -67,7 +67,7 @@ func listChannel(c *Client, url string, opt *ListChannelOptions) ([]*Channel, *R
 
 	channels := &dataListChannel{}
 
-	resp, err := c.Do(req, channels)
+	resp, err := c.Do(ctx, req, channels)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -80,8 +80,8 @@ func listChannel(c *Client, url string, opt *ListChannelOptions) ([]*Channel, *R
 // List lists the channel for an category.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/channels
-func (s *ChannelsService) List(opt *ListChannelOptions) ([]*Channel, *Response, error) {
-	channels, resp, err := listChannel(s.client, "channels", opt)
+func (s *ChannelsService) List(ctx context.Context, opt *ListChannelOptions) ([]*Channel, *Response, error) {
+	channels, resp, err := listChannel(ctx, s.client, "channels", opt)
 
 	return channels, resp, err
 }
@@ -89,14 +89,14 @@ func (s *ChannelsService) List(opt *ListChannelOptions) ([]*Channel, *Response,
 // Create a new channel.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/channels
-func (s *ChannelsService) Create(r *ChannelRequest) (*Channel, *Response, error) {
+func (s *ChannelsService) Create(ctx context.Context, r *ChannelRequest) (*Channel, *Response, error) {
 	req, err := s.client.NewRequest("POST", "channels", r)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	channel := &Channel{}
-	resp, err := s.client.Do(req, channel)
+	resp, err := s.client.Do(ctx, req, channel)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -107,7 +107,7 @@ func (s *ChannelsService) Create(r *ChannelRequest) (*Channel, *Response, error)
 // Get specific channel by name.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/channels/%7Bchannel_id%7D
-func (s *ChannelsService) Get(ch string) (*Channel, *Response, error) {
+func (s *ChannelsService) Get(ctx context.Context, ch string) (*Channel, *Response, error) {
 	u := fmt.Sprintf("channels/%s", ch)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -116,7 +116,7 @@ func (s *ChannelsService) Get(ch string) (*Channel, *Response, error) {
 
 	channel := &Channel{}
 
-	resp, err := s.client.Do(req, channel)
+	resp, err := s.client.Do(ctx, req, channel)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -127,7 +127,7 @@ func (s *ChannelsService) Get(ch string) (*Channel, *Response, error) {
 // Edit specific channel by name.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/channels/%7Bchannel_id%7D
-func (s *ChannelsService) Edit(ch string, r *ChannelRequest) (*Channel, *Response, error) {
+func (s *ChannelsService) Edit(ctx context.Context, ch string, r *ChannelRequest) (*Channel, *Response, error) {
 	u := fmt.Sprintf("channels/%s", ch)
 	req, err := s.client.NewRequest("PATCH", u, r)
 	if err != nil {
@@ -135,7 +135,7 @@ func (s *ChannelsService) Edit(ch string, r *ChannelRequest) (*Channel, *Respons
 	}
 
 	channel := &Channel{}
-	resp, err := s.client.Do(req, channel)
+	resp, err := s.client.Do(ctx, req, channel)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -146,22 +146,22 @@ func (s *ChannelsService) Edit(ch string, r *ChannelRequest) (*Channel, *Respons
 // Delete specific channel by name.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/channels/%7Bchannel_id%7D
-func (s *ChannelsService) Delete(ch string) (*Response, error) {
+func (s *ChannelsService) Delete(ctx context.Context, ch string) (*Response, error) {
 	u := fmt.Sprintf("channels/%s", ch)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
 
 // ListUser lists the user for an channel.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/channels/%7Bchannel_id%7D/users
-func (s *ChannelsService) ListUser(ch string, opt *ListUserOptions) ([]*User, *Response, error) {
+func (s *ChannelsService) ListUser(ctx context.Context, ch string, opt *ListUserOptions) ([]*User, *Response, error) {
 	u := fmt.Sprintf("channels/%s/users", ch)
-	users, resp, err := listUser(s.client, u, opt)
+	users, resp, err := listUser(ctx, s.client, u, opt)
 
 	return users, resp, err
 }
@@ -169,9 +169,9 @@ func (s *ChannelsService) ListUser(ch string, opt *ListUserOptions) ([]*User, *R
 // ListVideo lists the video for an channel.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/channels/%7Bchannel_id%7D/videos
-func (s *ChannelsService) ListVideo(ch string, opt *ListVideoOptions) ([]*Video, *Response, error) {
+func (s *ChannelsService) ListVideo(ctx context.Context, ch string, opt *ListVideoOptions) ([]*Video, *Response, error) {
 	u := fmt.Sprintf("channels/%s/videos", ch)
-	videos, resp, err := listVideo(s.client, u, opt)
+	videos, resp, err := listVideo(ctx, s.client, u, opt)
 
 	return videos, resp, err
 }
@@ -179,9 +179,9 @@ func (s *ChannelsService) ListVideo(ch string, opt *ListVideoOptions) ([]*Video,
 // GetVideo specific video by channel name and video ID.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/channels/%7Bchannel_id%7D/videos/%7Bvideo_id%7D
-func (s *ChannelsService) GetVideo(ch string, vid int) (*Video, *Response, error) {
+func (s *ChannelsService) GetVideo(ctx context.Context, ch string, vid int) (*Video, *Response, error) {
 	u := fmt.Sprintf("channels/%s/videos/%d", ch, vid)
-	video, resp, err := getVideo(s.client, u)
+	video, resp, err := getVideo(ctx, s.client, u)
 
 	return video, resp, err
 }
@@ -189,9 +189,9 @@ func (s *ChannelsService) GetVideo(ch string, vid int) (*Video, *Response, error
 // AddVideo add video to channel by ID.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/channels/%7Bchannel_id%7D/videos/%7Bvideo_id%7D
-func (s *ChannelsService) AddVideo(ch string, vid int) (*Response, error) {
+func (s *ChannelsService) AddVideo(ctx context.Context, ch string, vid int) (*Response, error) {
 	u := fmt.Sprintf("channels/%s/videos/%d", ch, vid)
-	resp, err := addVideo(s.client, u)
+	resp, err := addVideo(ctx, s.client, u)
 
 	return resp, err
 }
@@ -199,9 +199,45 @@ func (s *ChannelsService) AddVideo(ch string, vid int) (*Response, error) {
 // DeleteVideo specific video by channel name and video ID.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/channels/%7Bchannel_id%7D/videos/%7Bvideo_id%7D
-func (s *ChannelsService) DeleteVideo(ch string, vid int) (*Response, error) {
+func (s *ChannelsService) DeleteVideo(ctx context.Context, ch string, vid int) (*Response, error) {
 	u := fmt.Sprintf("channels/%s/videos/%d", ch, vid)
-	resp, err := deleteVideo(s.client, u)
+	resp, err := deleteVideo(ctx, s.client, u)
 
 	return resp, err
 }
+
+// ListModerator lists the moderators of channel.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/channels/%7Bchannel_id%7D/moderators
+func (s *ChannelsService) ListModerator(ctx context.Context, ch string, opt *ListUserOptions) ([]*User, *Response, error) {
+	u := fmt.Sprintf("channels/%s/moderators", ch)
+	users, resp, err := listUser(ctx, s.client, u, opt)
+
+	return users, resp, err
+}
+
+// AddModerator add moderator to channel by user ID.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/channels/%7Bchannel_id%7D/moderators/%7Buser_id%7D
+func (s *ChannelsService) AddModerator(ctx context.Context, ch string, uid string) (*Response, error) {
+	u := fmt.Sprintf("channels/%s/moderators/%s", ch, uid)
+	req, err := s.client.NewRequest("PUT", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// RemoveModerator remove moderator from channel by user ID.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/channels/%7Bchannel_id%7D/moderators/%7Buser_id%7D
+func (s *ChannelsService) RemoveModerator(ctx context.Context, ch string, uid string) (*Response, error) {
+	u := fmt.Sprintf("channels/%s/moderators/%s", ch, uid)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
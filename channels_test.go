@@ -1,6 +1,7 @@
 package vimeo
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -32,7 +33,7 @@ func TestChannelsService_List(t *testing.T) {
 	opt := &ListChannelOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	channels, _, err := client.Channels.List(opt)
+	channels, _, err := client.Channels.List(context.Background(), opt)
 	if err != nil {
 		t.Errorf("Channels.List returned unexpected error: %v", err)
 	}
@@ -65,7 +66,7 @@ func TestChannelsService_Create(t *testing.T) {
 		fmt.Fprint(w, `{"name": "name"}`)
 	})
 
-	channel, _, err := client.Channels.Create(input)
+	channel, _, err := client.Channels.Create(context.Background(), input)
 	if err != nil {
 		t.Errorf("Channels.Create returned unexpected error: %v", err)
 	}
@@ -85,7 +86,7 @@ func TestChannelsService_Get(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	channel, _, err := client.Channels.Get("1")
+	channel, _, err := client.Channels.Get(context.Background(), "1")
 	if err != nil {
 		t.Errorf("Channels.Get returned unexpected error: %v", err)
 	}
@@ -118,7 +119,7 @@ func TestChannelsService_Edit(t *testing.T) {
 		fmt.Fprint(w, `{"name": "name"}`)
 	})
 
-	channel, _, err := client.Channels.Edit("1", input)
+	channel, _, err := client.Channels.Edit(context.Background(), "1", input)
 	if err != nil {
 		t.Errorf("Channels.Edit returned unexpected error: %v", err)
 	}
@@ -137,7 +138,7 @@ func TestChannelsService_Delete(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Channels.Delete("1")
+	_, err := client.Channels.Delete(context.Background(), "1")
 	if err != nil {
 		t.Errorf("Channels.Delete returned unexpected error: %v", err)
 	}
@@ -159,7 +160,7 @@ func TestChannelsService_ListUser(t *testing.T) {
 	opt := &ListUserOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	users, _, err := client.Channels.ListUser("1", opt)
+	users, _, err := client.Channels.ListUser(context.Background(), "1", opt)
 	if err != nil {
 		t.Errorf("Channels.ListUser returned unexpected error: %v", err)
 	}
@@ -186,7 +187,7 @@ func TestChannelsService_ListVideo(t *testing.T) {
 	opt := &ListVideoOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	videos, _, err := client.Channels.ListVideo("1", opt)
+	videos, _, err := client.Channels.ListVideo(context.Background(), "1", opt)
 	if err != nil {
 		t.Errorf("Channels.ListVideo returned unexpected error: %v", err)
 	}
@@ -197,6 +198,21 @@ func TestChannelsService_ListVideo(t *testing.T) {
 	}
 }
 
+func TestChannelsService_ListVideo_sortAdded(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/channels/1/videos", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{"sort": "added", "direction": "desc"})
+		fmt.Fprint(w, `{"data": []}`)
+	})
+
+	opt := &ListVideoOptions{Sort: "added", Direction: "desc"}
+	if _, _, err := client.Channels.ListVideo(context.Background(), "1", opt); err != nil {
+		t.Errorf("Channels.ListVideo returned unexpected error: %v", err)
+	}
+}
+
 func TestChannelsService_GetVideo(t *testing.T) {
 	setup()
 	defer teardown()
@@ -206,7 +222,7 @@ func TestChannelsService_GetVideo(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	video, _, err := client.Channels.GetVideo("ch", 1)
+	video, _, err := client.Channels.GetVideo(context.Background(), "ch", 1)
 	if err != nil {
 		t.Errorf("Channels.GetVideo returned unexpected error: %v", err)
 	}
@@ -225,7 +241,7 @@ func TestChannelsService_DeleteVideo(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Channels.DeleteVideo("ch", 1)
+	_, err := client.Channels.DeleteVideo(context.Background(), "ch", 1)
 	if err != nil {
 		t.Errorf("Channels.DeleteVideo returned unexpected error: %v", err)
 	}
@@ -239,8 +255,63 @@ func TestChannelsService_AddVideo(t *testing.T) {
 		testMethod(t, r, "PUT")
 	})
 
-	_, err := client.Channels.AddVideo("ch", 1)
+	_, err := client.Channels.AddVideo(context.Background(), "ch", 1)
 	if err != nil {
 		t.Errorf("Channels.AddVideo returned unexpected error: %v", err)
 	}
 }
+
+func TestChannelsService_ListModerator(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/channels/ch/moderators", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"page":     "1",
+			"per_page": "2",
+		})
+		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
+	})
+
+	opt := &ListUserOptions{
+		ListOptions: ListOptions{Page: 1, PerPage: 2},
+	}
+	users, _, err := client.Channels.ListModerator(context.Background(), "ch", opt)
+	if err != nil {
+		t.Errorf("Channels.ListModerator returned unexpected error: %v", err)
+	}
+
+	want := []*User{{Name: "Test"}}
+	if !reflect.DeepEqual(users, want) {
+		t.Errorf("Channels.ListModerator returned %+v, want %+v", users, want)
+	}
+}
+
+func TestChannelsService_AddModerator(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/channels/ch/moderators/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+	})
+
+	_, err := client.Channels.AddModerator(context.Background(), "ch", "1")
+	if err != nil {
+		t.Errorf("Channels.AddModerator returned unexpected error: %v", err)
+	}
+}
+
+func TestChannelsService_RemoveModerator(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/channels/ch/moderators/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Channels.RemoveModerator(context.Background(), "ch", "1")
+	if err != nil {
+		t.Errorf("Channels.RemoveModerator returned unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+package vimeo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// CommentsService handles communication with the comments related
+// methods of the Vimeo API.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/videos#comments
+type CommentsService service
+
+// commentPath turns a comment URI, as found in Comment.URI, into a request path.
+func commentPath(uri string) string {
+	return strings.TrimPrefix(uri, "/")
+}
+
+func listComment(ctx context.Context, c *Client, url string, opt interface{}) ([]*Comment, *Response, error) {
+	u, err := addOptions(url, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comments := &dataListComment{}
+
+	resp, err := c.Do(ctx, req, comments)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.setPaging(comments)
+
+	return comments.Data, resp, err
+}
+
+func editComment(ctx context.Context, c *Client, method string, url string, text string) (*Comment, *Response, error) {
+	req, err := c.NewRequest(method, url, &CommentRequest{Text: text})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment := &Comment{}
+	resp, err := c.Do(ctx, req, comment)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return comment, resp, nil
+}
+
+// List lists the comments of the video.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/comments
+func (s *CommentsService) List(ctx context.Context, vid int, opt *ListCommentOptions) ([]*Comment, *Response, error) {
+	return listComment(ctx, s.client, fmt.Sprintf("videos/%d/comments", vid), opt)
+}
+
+// Add adds a comment to the video.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/comments
+func (s *CommentsService) Add(ctx context.Context, vid int, text string) (*Comment, *Response, error) {
+	return editComment(ctx, s.client, "POST", fmt.Sprintf("videos/%d/comments", vid), text)
+}
+
+// Edit edits the comment identified by its URI.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/comments/%7Bcomment_id%7D
+func (s *CommentsService) Edit(ctx context.Context, commentURI string, text string) (*Comment, *Response, error) {
+	return editComment(ctx, s.client, "PATCH", commentPath(commentURI), text)
+}
+
+// Delete deletes the comment identified by its URI.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/comments/%7Bcomment_id%7D
+func (s *CommentsService) Delete(ctx context.Context, commentURI string) (*Response, error) {
+	req, err := s.client.NewRequest("DELETE", commentPath(commentURI), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListReplies lists the replies to the comment identified by its URI.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/comments/%7Bcomment_id%7D/replies
+func (s *CommentsService) ListReplies(ctx context.Context, commentURI string, opt *ListRepliesOptions) ([]*Comment, *Response, error) {
+	return listComment(ctx, s.client, commentPath(commentURI)+"/replies", opt)
+}
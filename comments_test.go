@@ -0,0 +1,146 @@
+package vimeo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCommentsService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/comments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"page":     "1",
+			"per_page": "2",
+		})
+		fmt.Fprint(w, `{"data": [{"uri": "/videos/1/comments/2", "text": "Test", "created_on": "2017-01-01T10:00:00Z", "user": {"name": "Test"}}]}`)
+	})
+
+	opt := &ListCommentOptions{
+		ListOptions: ListOptions{Page: 1, PerPage: 2},
+	}
+	comments, _, err := client.Comments.List(context.Background(), 1, opt)
+	if err != nil {
+		t.Errorf("Comments.List returned unexpected error: %v", err)
+	}
+
+	want := []*Comment{{
+		URI:       "/videos/1/comments/2",
+		Text:      "Test",
+		CreatedOn: Timestamp{time.Date(2017, time.January, 1, 10, 0, 0, 0, time.UTC)},
+		User:      &User{Name: "Test"},
+	}}
+	if !reflect.DeepEqual(comments, want) {
+		t.Errorf("Comments.List returned %+v, want %+v", comments, want)
+	}
+}
+
+func TestCommentsService_Add(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &CommentRequest{
+		Text: "name",
+	}
+
+	mux.HandleFunc("/videos/1/comments", func(w http.ResponseWriter, r *http.Request) {
+		v := &CommentRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Comments.Add body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"text": "name"}`)
+	})
+
+	comment, _, err := client.Comments.Add(context.Background(), 1, "name")
+	if err != nil {
+		t.Errorf("Comments.Add returned unexpected error: %v", err)
+	}
+
+	want := &Comment{Text: "name"}
+	if !reflect.DeepEqual(comment, want) {
+		t.Errorf("Comments.Add returned %+v, want %+v", comment, want)
+	}
+}
+
+func TestCommentsService_Edit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &CommentRequest{
+		Text: "name",
+	}
+
+	mux.HandleFunc("/videos/1/comments/2", func(w http.ResponseWriter, r *http.Request) {
+		v := &CommentRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PATCH")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Comments.Edit body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"text": "name"}`)
+	})
+
+	comment, _, err := client.Comments.Edit(context.Background(), "/videos/1/comments/2", "name")
+	if err != nil {
+		t.Errorf("Comments.Edit returned unexpected error: %v", err)
+	}
+
+	want := &Comment{Text: "name"}
+	if !reflect.DeepEqual(comment, want) {
+		t.Errorf("Comments.Edit returned %+v, want %+v", comment, want)
+	}
+}
+
+func TestCommentsService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/comments/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Comments.Delete(context.Background(), "/videos/1/comments/2")
+	if err != nil {
+		t.Errorf("Comments.Delete returned unexpected error: %v", err)
+	}
+}
+
+func TestCommentsService_ListReplies(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/comments/2/replies", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"page":     "1",
+			"per_page": "2",
+		})
+		fmt.Fprint(w, `{"data": [{"text": "Test"}]}`)
+	})
+
+	opt := &ListRepliesOptions{
+		ListOptions: ListOptions{Page: 1, PerPage: 2},
+	}
+	replies, _, err := client.Comments.ListReplies(context.Background(), "/videos/1/comments/2", opt)
+	if err != nil {
+		t.Errorf("Comments.ListReplies returned unexpected error: %v", err)
+	}
+
+	want := []*Comment{{Text: "Test"}}
+	if !reflect.DeepEqual(replies, want) {
+		t.Errorf("Comments.ListReplies returned %+v, want %+v", replies, want)
+	}
+}
@@ -1,6 +1,7 @@
 package vimeo
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -24,7 +25,7 @@ func TestContentRatingsService_List(t *testing.T) {
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
 
-	ratings, _, err := client.ContentRatings.List(opt)
+	ratings, _, err := client.ContentRatings.List(context.Background(), opt)
 	if err != nil {
 		t.Errorf("ContentRatings.List returned unexpected error: %v", err)
 	}
@@ -1,5 +1,7 @@
 package vimeo
 
+import "context"
+
 // ContentRatingsService handles communication with the content ratings related
 // methods of the Vimeo API.
 //
@@ -26,7 +28,7 @@ type ListContentRatingOptions struct {
 // List the content rating.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/contentratings
-func (s *ContentRatingsService) List(opt *ListContentRatingOptions) ([]*ContentRating, *Response, error) {
+func (s *ContentRatingsService) List(ctx context.Context, opt *ListContentRatingOptions) ([]*ContentRating, *Response, error) {
 	u, err := addOptions("contentratings", opt)
 	if err != nil {
 		return nil, nil, err
@@ -39,7 +41,7 @@ func (s *ContentRatingsService) List(opt *ListContentRatingOptions) ([]*ContentR
 
 	ratings := &contentRatingList{}
 
-	resp, err := s.client.Do(req, ratings)
+	resp, err := s.client.Do(ctx, req, ratings)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -1,5 +1,7 @@
 package vimeo
 
+import "context"
+
 // CreativeCommonsService handles communication with the creative commons related
 // methods of the Vimeo API.
 //
@@ -26,7 +28,7 @@ type ListCreativeCommonOptions struct {
 // List the creative common.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/creativecommons
-func (s *CreativeCommonsService) List(opt *ListCreativeCommonOptions) ([]*CreativeCommon, *Response, error) {
+func (s *CreativeCommonsService) List(ctx context.Context, opt *ListCreativeCommonOptions) ([]*CreativeCommon, *Response, error) {
 	u, err := addOptions("creativecommons", opt)
 	if err != nil {
 		return nil, nil, err
@@ -39,7 +41,7 @@ func (s *CreativeCommonsService) List(opt *ListCreativeCommonOptions) ([]*Creati
 
 	commons := &creativeCommonList{}
 
-	resp, err := s.client.Do(req, commons)
+	resp, err := s.client.Do(ctx, req, commons)
 	if err != nil {
 		return nil, resp, err
 	}
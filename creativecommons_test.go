@@ -1,6 +1,7 @@
 package vimeo
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -24,7 +25,7 @@ func TestCreativeCommonsService_List(t *testing.T) {
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
 
-	commons, _, err := client.CreativeCommons.List(opt)
+	commons, _, err := client.CreativeCommons.List(context.Background(), opt)
 	if err != nil {
 		t.Errorf("CreativeCommons.List returned unexpected error: %v", err)
 	}
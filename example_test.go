@@ -0,0 +1,33 @@
+package vimeo_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/silentsokolov/go-vimeo"
+)
+
+func ExampleRoundTripperFunc() {
+	stub := func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"application/json"}},
+			Body:       ioutil.NopCloser(strings.NewReader(`{"name": "Test"}`)),
+			Request:    req,
+		}, nil
+	}
+
+	client := vimeo.NewClient(&http.Client{Transport: vimeo.RoundTripperFunc(stub)})
+
+	user, _, err := client.Users.Get(context.Background(), "1")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(user.Name)
+	// Output: Test
+}
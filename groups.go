@@ -1,9 +1,10 @@
 package vimeo
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"strings"
-	"time"
 )
 
 // GroupsService handles communication with the group related
@@ -23,8 +24,8 @@ type Group struct {
 	Name         string    `json:"name,omitempty"`
 	Description  string    `json:"description,omitempty"`
 	Link         string    `json:"link,omitempty"`
-	CreatedTime  time.Time `json:"created_time,omitempty"`
-	ModifiedTime time.Time `json:"modified_time,omitempty"`
+	CreatedTime  Timestamp `json:"created_time,omitempty"`
+	ModifiedTime Timestamp `json:"modified_time,omitempty"`
 	Privacy      *Privacy  `json:"privacy,omitempty"`
 	Pictures     *Pictures `json:"pictures,omitempty"`
 	Header       *Header   `json:"header,omitempty"`
@@ -54,7 +55,7 @@ func (g Group) GetID() string {
 	return id
 }
 
-func listGroup(c *Client, url string, opt *ListGroupOptions) ([]*Group, *Response, error) {
+func listGroup(ctx context.Context, c *Client, url string, opt *ListGroupOptions) ([]*Group, *Response, error) {
 	u, err := addOptions(url, opt)
 	if err != nil {
 		return nil, nil, err
@@ -67,7 +68,7 @@ func listGroup(c *Client, url string, opt *ListGroupOptions) ([]*Group, *Respons
 
 	groups := &dataListGroup{}
 
-	resp, err := c.Do(req, groups)
+	resp, err := c.Do(ctx, req, groups)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -80,8 +81,8 @@ func listGroup(c *Client, url string, opt *ListGroupOptions) ([]*Group, *Respons
 // List lists the group.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/groups
-func (s *GroupsService) List(opt *ListGroupOptions) ([]*Group, *Response, error) {
-	groups, resp, err := listGroup(s.client, "groups", opt)
+func (s *GroupsService) List(ctx context.Context, opt *ListGroupOptions) ([]*Group, *Response, error) {
+	groups, resp, err := listGroup(ctx, s.client, "groups", opt)
 
 	return groups, resp, err
 }
@@ -89,14 +90,14 @@ func (s *GroupsService) List(opt *ListGroupOptions) ([]*Group, *Response, error)
 // Create a new group.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/groups
-func (s *GroupsService) Create(r *GroupRequest) (*Group, *Response, error) {
+func (s *GroupsService) Create(ctx context.Context, r *GroupRequest) (*Group, *Response, error) {
 	req, err := s.client.NewRequest("POST", "groups", r)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	group := &Group{}
-	resp, err := s.client.Do(req, group)
+	resp, err := s.client.Do(ctx, req, group)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -107,7 +108,7 @@ func (s *GroupsService) Create(r *GroupRequest) (*Group, *Response, error) {
 // Get specific group by name.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/groups/%7Bgroup_id%7D
-func (s *GroupsService) Get(gr string) (*Group, *Response, error) {
+func (s *GroupsService) Get(ctx context.Context, gr string) (*Group, *Response, error) {
 	u := fmt.Sprintf("groups/%s", gr)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -116,7 +117,7 @@ func (s *GroupsService) Get(gr string) (*Group, *Response, error) {
 
 	group := &Group{}
 
-	resp, err := s.client.Do(req, group)
+	resp, err := s.client.Do(ctx, req, group)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -127,22 +128,22 @@ func (s *GroupsService) Get(gr string) (*Group, *Response, error) {
 // Delete specific group by name.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/groups/%7Bgroup_id%7D
-func (s *GroupsService) Delete(gr string) (*Response, error) {
+func (s *GroupsService) Delete(ctx context.Context, gr string) (*Response, error) {
 	u := fmt.Sprintf("groups/%s", gr)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
 
 // ListUser lists the user for an group.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/groups/%7Bgroup_id%7D/users
-func (s *GroupsService) ListUser(gr string, opt *ListUserOptions) ([]*User, *Response, error) {
+func (s *GroupsService) ListUser(ctx context.Context, gr string, opt *ListUserOptions) ([]*User, *Response, error) {
 	u := fmt.Sprintf("groups/%s/users", gr)
-	users, resp, err := listUser(s.client, u, opt)
+	users, resp, err := listUser(ctx, s.client, u, opt)
 
 	return users, resp, err
 }
@@ -150,9 +151,9 @@ func (s *GroupsService) ListUser(gr string, opt *ListUserOptions) ([]*User, *Res
 // ListVideo lists the video for an group.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/groups/%7Bgroup_id%7D/videos
-func (s *GroupsService) ListVideo(gr string, opt *ListVideoOptions) ([]*Video, *Response, error) {
+func (s *GroupsService) ListVideo(ctx context.Context, gr string, opt *ListVideoOptions) ([]*Video, *Response, error) {
 	u := fmt.Sprintf("groups/%s/videos", gr)
-	videos, resp, err := listVideo(s.client, u, opt)
+	videos, resp, err := listVideo(ctx, s.client, u, opt)
 
 	return videos, resp, err
 }
@@ -160,19 +161,33 @@ func (s *GroupsService) ListVideo(gr string, opt *ListVideoOptions) ([]*Video, *
 // GetVideo specific video by group name and video ID.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/groups/%7Bgroup_id%7D/videos/%7Bvideo_id%7D
-func (s *GroupsService) GetVideo(gr string, vid int) (*Video, *Response, error) {
+func (s *GroupsService) GetVideo(ctx context.Context, gr string, vid int) (*Video, *Response, error) {
 	u := fmt.Sprintf("groups/%s/videos/%d", gr, vid)
-	video, resp, err := getVideo(s.client, u)
+	video, resp, err := getVideo(ctx, s.client, u)
 
 	return video, resp, err
 }
 
+// AddVideo add video to group by ID. Only the members of the group can
+// post videos to it, otherwise the returned error says so.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/groups/%7Bgroup_id%7D/videos/%7Bvideo_id%7D
+func (s *GroupsService) AddVideo(ctx context.Context, gr string, vid int) (*Response, error) {
+	u := fmt.Sprintf("groups/%s/videos/%d", gr, vid)
+	resp, err := addVideo(ctx, s.client, u)
+	if err != nil && resp != nil && resp.StatusCode == http.StatusForbidden {
+		err = fmt.Errorf("video %d can't be added to group %s by a non-member: %w", vid, gr, err)
+	}
+
+	return resp, err
+}
+
 // DeleteVideo specific video by group name and video ID.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/groups/%7Bgroup_id%7D/videos/%7Bvideo_id%7D
-func (s *GroupsService) DeleteVideo(gr string, vid int) (*Response, error) {
+func (s *GroupsService) DeleteVideo(ctx context.Context, gr string, vid int) (*Response, error) {
 	u := fmt.Sprintf("groups/%s/videos/%d", gr, vid)
-	resp, err := deleteVideo(s.client, u)
+	resp, err := deleteVideo(ctx, s.client, u)
 
 	return resp, err
 }
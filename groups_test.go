@@ -1,10 +1,13 @@
 package vimeo
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -32,7 +35,7 @@ func TestGroupsService_List(t *testing.T) {
 	opt := &ListGroupOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	groups, _, err := client.Groups.List(opt)
+	groups, _, err := client.Groups.List(context.Background(), opt)
 	if err != nil {
 		t.Errorf("Groups.List returned unexpected error: %v", err)
 	}
@@ -64,7 +67,7 @@ func TestGroupsService_Create(t *testing.T) {
 		fmt.Fprint(w, `{"name": "name"}`)
 	})
 
-	group, _, err := client.Groups.Create(input)
+	group, _, err := client.Groups.Create(context.Background(), input)
 	if err != nil {
 		t.Errorf("Groups.Create returned unexpected error: %v", err)
 	}
@@ -84,7 +87,7 @@ func TestGroupsService_Get(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	group, _, err := client.Groups.Get("1")
+	group, _, err := client.Groups.Get(context.Background(), "1")
 	if err != nil {
 		t.Errorf("Groups.Get returned unexpected error: %v", err)
 	}
@@ -103,7 +106,7 @@ func TestGroupsService_Delete(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Groups.Delete("1")
+	_, err := client.Groups.Delete(context.Background(), "1")
 	if err != nil {
 		t.Errorf("Groups.Delete returned unexpected error: %v", err)
 	}
@@ -125,7 +128,7 @@ func TestGroupsService_ListUser(t *testing.T) {
 	opt := &ListUserOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	users, _, err := client.Groups.ListUser("1", opt)
+	users, _, err := client.Groups.ListUser(context.Background(), "1", opt)
 	if err != nil {
 		t.Errorf("Groups.ListUser returned unexpected error: %v", err)
 	}
@@ -152,7 +155,7 @@ func TestGroupsService_ListVideo(t *testing.T) {
 	opt := &ListVideoOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	videos, _, err := client.Groups.ListVideo("1", opt)
+	videos, _, err := client.Groups.ListVideo(context.Background(), "1", opt)
 	if err != nil {
 		t.Errorf("Groups.ListVideo returned unexpected error: %v", err)
 	}
@@ -172,7 +175,7 @@ func TestGroupsService_GetVideo(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	video, _, err := client.Groups.GetVideo("gr", 1)
+	video, _, err := client.Groups.GetVideo(context.Background(), "gr", 1)
 	if err != nil {
 		t.Errorf("Groups.GetVideo returned unexpected error: %v", err)
 	}
@@ -191,8 +194,42 @@ func TestGroupsService_DeleteVideo(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Groups.DeleteVideo("gr", 1)
+	_, err := client.Groups.DeleteVideo(context.Background(), "gr", 1)
 	if err != nil {
 		t.Errorf("Groups.DeleteVideo returned unexpected error: %v", err)
 	}
 }
+
+func TestGroupsService_AddVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/groups/gr/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+	})
+
+	_, err := client.Groups.AddVideo(context.Background(), "gr", 1)
+	if err != nil {
+		t.Errorf("Groups.AddVideo returned unexpected error: %v", err)
+	}
+}
+
+func TestGroupsService_AddVideo_notMember(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/groups/gr/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"error": "forbidden"}`)
+	})
+
+	_, err := client.Groups.AddVideo(context.Background(), "gr", 1)
+	if err == nil || !strings.Contains(err.Error(), "non-member") {
+		t.Errorf("Groups.AddVideo returned error %v, want non-member error", err)
+	}
+
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Errorf("Groups.AddVideo error %v does not wrap *ErrorResponse", err)
+	}
+}
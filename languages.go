@@ -1,5 +1,7 @@
 package vimeo
 
+import "context"
+
 // LanguagesService handles communication with the languages related
 // methods of the Vimeo API.
 //
@@ -27,7 +29,7 @@ type ListLanguageOptions struct {
 // List the languages.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/languages
-func (s *LanguagesService) List(opt *ListLanguageOptions) ([]*Language, *Response, error) {
+func (s *LanguagesService) List(ctx context.Context, opt *ListLanguageOptions) ([]*Language, *Response, error) {
 	u, err := addOptions("languages", opt)
 	if err != nil {
 		return nil, nil, err
@@ -40,7 +42,7 @@ func (s *LanguagesService) List(opt *ListLanguageOptions) ([]*Language, *Respons
 
 	languages := &languageList{}
 
-	resp, err := s.client.Do(req, languages)
+	resp, err := s.client.Do(ctx, req, languages)
 	if err != nil {
 		return nil, resp, err
 	}
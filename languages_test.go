@@ -1,6 +1,7 @@
 package vimeo
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -24,7 +25,7 @@ func TestLanguagesService_List(t *testing.T) {
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
 
-	languages, _, err := client.Languages.List(opt)
+	languages, _, err := client.Languages.List(context.Background(), opt)
 	if err != nil {
 		t.Errorf("Languages.List returned unexpected error: %v", err)
 	}
@@ -0,0 +1,197 @@
+package vimeo
+
+import "context"
+
+// LiveService handles communication with the live events related
+// methods of the Vimeo API.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/live
+type LiveService service
+
+type dataListLiveEvent struct {
+	Data []*LiveEvent `json:"data,omitempty"`
+	pagination
+}
+
+// LiveEvent represents a live event.
+// RTMPLink and StreamKey are set once the event is activated.
+type LiveEvent struct {
+	URI               string    `json:"uri,omitempty"`
+	Title             string    `json:"title,omitempty"`
+	Link              string    `json:"link,omitempty"`
+	StreamTitle       string    `json:"stream_title,omitempty"`
+	StreamDescription string    `json:"stream_description,omitempty"`
+	StreamPrivacy     *Privacy  `json:"stream_privacy,omitempty"`
+	RTMPLink          string    `json:"rtmp_link,omitempty"`
+	StreamKey         string    `json:"stream_key,omitempty"`
+	StreamingStatus   string    `json:"streaming_status,omitempty"`
+	CreatedTime       Timestamp `json:"created_time,omitempty"`
+	User              *User     `json:"user,omitempty"`
+	Pictures          *Pictures `json:"pictures,omitempty"`
+}
+
+// LiveEventRequest represents a request to create/edit a live event.
+type LiveEventRequest struct {
+	Title             string          `json:"title,omitempty"`
+	StreamTitle       string          `json:"stream_title,omitempty"`
+	StreamDescription string          `json:"stream_description,omitempty"`
+	StreamPrivacy     *PrivacyRequest `json:"stream_privacy,omitempty"`
+}
+
+// ListLiveEventOptions specifies the optional parameters to the
+// LiveService.List method.
+type ListLiveEventOptions struct {
+	Query     string `url:"query,omitempty"`
+	Sort      string `url:"sort,omitempty"`
+	Direction string `url:"direction,omitempty"`
+	ListOptions
+}
+
+func liveEventsURL(uid string, format string, a ...interface{}) (string, error) {
+	return userPath(uid, "live_events"+format, a...)
+}
+
+func (s *LiveService) do(ctx context.Context, method string, u string, body interface{}) (*LiveEvent, *Response, error) {
+	req, err := s.client.NewRequest(method, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	event := &LiveEvent{}
+
+	resp, err := s.client.Do(ctx, req, event)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return event, resp, err
+}
+
+// List lists the live events of user.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/live_events
+func (s *LiveService) List(ctx context.Context, uid string, opt *ListLiveEventOptions) ([]*LiveEvent, *Response, error) {
+	u, err := liveEventsURL(uid, "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u, err = addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	events := &dataListLiveEvent{}
+
+	resp, err := s.client.Do(ctx, req, events)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.setPaging(events)
+
+	return events.Data, resp, err
+}
+
+// Create creates a new live event.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/live_events
+func (s *LiveService) Create(ctx context.Context, uid string, r *LiveEventRequest) (*LiveEvent, *Response, error) {
+	u, err := liveEventsURL(uid, "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.do(ctx, "POST", u, r)
+}
+
+// Get returns specific live event by ID.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/live_events/%7Blive_event_id%7D
+func (s *LiveService) Get(ctx context.Context, uid string, id int) (*LiveEvent, *Response, error) {
+	u, err := liveEventsURL(uid, "/%d", id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.do(ctx, "GET", u, nil)
+}
+
+// Edit edits specific live event by ID.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/live_events/%7Blive_event_id%7D
+func (s *LiveService) Edit(ctx context.Context, uid string, id int, r *LiveEventRequest) (*LiveEvent, *Response, error) {
+	u, err := liveEventsURL(uid, "/%d", id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.do(ctx, "PATCH", u, r)
+}
+
+// Delete deletes specific live event by ID.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/live_events/%7Blive_event_id%7D
+func (s *LiveService) Delete(ctx context.Context, uid string, id int) (*Response, error) {
+	u, err := liveEventsURL(uid, "/%d", id)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// Activate activates the live event. The returned event carries the RTMP
+// ingest URL and the stream key to broadcast to.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/live_events/%7Blive_event_id%7D/activate
+func (s *LiveService) Activate(ctx context.Context, uid string, id int) (*LiveEvent, *Response, error) {
+	u, err := liveEventsURL(uid, "/%d/activate", id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.do(ctx, "POST", u, nil)
+}
+
+// Start starts the stream of the activated live event.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/live_events/%7Blive_event_id%7D/start
+func (s *LiveService) Start(ctx context.Context, uid string, id int) (*LiveEvent, *Response, error) {
+	u, err := liveEventsURL(uid, "/%d/start", id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.do(ctx, "POST", u, nil)
+}
+
+// End ends the stream of the live event.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/live_events/%7Blive_event_id%7D/end
+func (s *LiveService) End(ctx context.Context, uid string, id int) (*LiveEvent, *Response, error) {
+	u, err := liveEventsURL(uid, "/%d/end", id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.do(ctx, "POST", u, nil)
+}
@@ -0,0 +1,186 @@
+package vimeo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLiveService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/live_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"page":     "1",
+			"per_page": "2",
+		})
+		fmt.Fprint(w, `{"data": [{"title": "Test"}]}`)
+	})
+
+	opt := &ListLiveEventOptions{
+		ListOptions: ListOptions{Page: 1, PerPage: 2},
+	}
+	events, _, err := client.Live.List(context.Background(), "1", opt)
+	if err != nil {
+		t.Errorf("Live.List returned unexpected error: %v", err)
+	}
+
+	want := []*LiveEvent{{Title: "Test"}}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("Live.List returned %+v, want %+v", events, want)
+	}
+}
+
+func TestLiveService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &LiveEventRequest{
+		Title: "name",
+	}
+
+	mux.HandleFunc("/me/live_events", func(w http.ResponseWriter, r *http.Request) {
+		v := &LiveEventRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Live.Create body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"title": "name"}`)
+	})
+
+	event, _, err := client.Live.Create(context.Background(), "", input)
+	if err != nil {
+		t.Errorf("Live.Create returned unexpected error: %v", err)
+	}
+
+	want := &LiveEvent{Title: "name"}
+	if !reflect.DeepEqual(event, want) {
+		t.Errorf("Live.Create returned %+v, want %+v", event, want)
+	}
+}
+
+func TestLiveService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/live_events/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"title": "Test"}`)
+	})
+
+	event, _, err := client.Live.Get(context.Background(), "1", 2)
+	if err != nil {
+		t.Errorf("Live.Get returned unexpected error: %v", err)
+	}
+
+	want := &LiveEvent{Title: "Test"}
+	if !reflect.DeepEqual(event, want) {
+		t.Errorf("Live.Get returned %+v, want %+v", event, want)
+	}
+}
+
+func TestLiveService_Edit_privacy(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/live_events/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		body, _ := ioutil.ReadAll(r.Body)
+		if got, want := strings.TrimSpace(string(body)), `{"stream_privacy":{"view":"unlisted"}}`; got != want {
+			t.Errorf("Live.Edit body is %s, want %s", got, want)
+		}
+		fmt.Fprint(w, `{"title": "name"}`)
+	})
+
+	input := &LiveEventRequest{StreamPrivacy: &PrivacyRequest{View: "unlisted"}}
+	if _, _, err := client.Live.Edit(context.Background(), "1", 2, input); err != nil {
+		t.Errorf("Live.Edit returned unexpected error: %v", err)
+	}
+}
+
+func TestLiveService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/live_events/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Live.Delete(context.Background(), "1", 2)
+	if err != nil {
+		t.Errorf("Live.Delete returned unexpected error: %v", err)
+	}
+}
+
+func TestLiveService_Delete_invalidID(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request to %v", r.URL)
+	})
+
+	if _, err := client.Live.Delete(context.Background(), "../me", 2); err == nil {
+		t.Error("Live.Delete expected error to be returned")
+	}
+}
+
+func TestLiveService_Activate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/live_events/2/activate", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"rtmp_link": "rtmp://rtmp.cloud.vimeo.com/live", "stream_key": "key"}`)
+	})
+
+	event, _, err := client.Live.Activate(context.Background(), "1", 2)
+	if err != nil {
+		t.Errorf("Live.Activate returned unexpected error: %v", err)
+	}
+
+	want := &LiveEvent{RTMPLink: "rtmp://rtmp.cloud.vimeo.com/live", StreamKey: "key"}
+	if !reflect.DeepEqual(event, want) {
+		t.Errorf("Live.Activate returned %+v, want %+v", event, want)
+	}
+}
+
+func TestLiveService_StartEnd(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/live_events/2/start", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"streaming_status": "streaming"}`)
+	})
+	mux.HandleFunc("/users/1/live_events/2/end", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"streaming_status": "done"}`)
+	})
+
+	event, _, err := client.Live.Start(context.Background(), "1", 2)
+	if err != nil {
+		t.Errorf("Live.Start returned unexpected error: %v", err)
+	}
+	if want := (&LiveEvent{StreamingStatus: "streaming"}); !reflect.DeepEqual(event, want) {
+		t.Errorf("Live.Start returned %+v, want %+v", event, want)
+	}
+
+	event, _, err = client.Live.End(context.Background(), "1", 2)
+	if err != nil {
+		t.Errorf("Live.End returned unexpected error: %v", err)
+	}
+	if want := (&LiveEvent{StreamingStatus: "done"}); !reflect.DeepEqual(event, want) {
+		t.Errorf("Live.End returned %+v, want %+v", event, want)
+	}
+}
@@ -0,0 +1,297 @@
+package vimeo
+
+import (
+	"context"
+	"fmt"
+)
+
+// OnDemandService handles communication with the on demand related
+// methods of the Vimeo API.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/endpoints/ondemand
+type OnDemandService service
+
+type dataListOnDemand struct {
+	Data []*OnDemand `json:"data,omitempty"`
+	pagination
+}
+
+// OnDemandPublished internal object provides access to the publication
+// state of an on demand page.
+type OnDemandPublished struct {
+	Enabled bool      `json:"enabled"`
+	Time    Timestamp `json:"time,omitempty"`
+}
+
+// OnDemandPricing internal object provides access to the price of an on
+// demand page, to buy or to rent it.
+type OnDemandPricing struct {
+	Active bool `json:"active"`
+	// Price is keyed by currency, such as "USD".
+	Price map[string]float64 `json:"price,omitempty"`
+	// Period is the rental period, such as "24 hour" or "1 week".
+	Period string `json:"period,omitempty"`
+}
+
+// OnDemand represents an on demand page.
+type OnDemand struct {
+	URI          string             `json:"uri,omitempty"`
+	Name         string             `json:"name,omitempty"`
+	Description  string             `json:"description,omitempty"`
+	Link         string             `json:"link,omitempty"`
+	Type         string             `json:"type,omitempty"`
+	CreatedTime  Timestamp          `json:"created_time,omitempty"`
+	ModifiedTime Timestamp          `json:"modified_time,omitempty"`
+	Published    *OnDemandPublished `json:"published,omitempty"`
+	Buy          *OnDemandPricing   `json:"buy,omitempty"`
+	Rent         *OnDemandPricing   `json:"rent,omitempty"`
+	Pictures     *Pictures          `json:"pictures,omitempty"`
+	User         *User              `json:"user,omitempty"`
+	ResourceKey  string             `json:"resource_key,omitempty"`
+}
+
+// ListOnDemandOptions specifies the optional parameters to the
+// OnDemandService.List method.
+type ListOnDemandOptions struct {
+	Filter    string `url:"filter,omitempty"`
+	Sort      string `url:"sort,omitempty"`
+	Direction string `url:"direction,omitempty"`
+	ListOptions
+}
+
+// OnDemandRequest represents a request to create/edit an on demand page.
+type OnDemandRequest struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Type is "film" or "series".
+	Type          string           `json:"type,omitempty"`
+	Link          string           `json:"link,omitempty"`
+	ContentRating []string         `json:"content_rating,omitempty"`
+	Buy           *OnDemandPricing `json:"buy,omitempty"`
+	Rent          *OnDemandPricing `json:"rent,omitempty"`
+}
+
+// OnDemandVideoRequest represents a request to add a video to an on demand
+// page.
+type OnDemandVideoRequest struct {
+	// Type is one of "main", "trailer" or "extra".
+	Type     string `json:"type,omitempty"`
+	Position int    `json:"position,omitempty"`
+}
+
+type dataListOnDemandSeason struct {
+	Data []*OnDemandSeason `json:"data,omitempty"`
+	pagination
+}
+
+// OnDemandSeason represents a season of an on demand series.
+type OnDemandSeason struct {
+	URI         string `json:"uri,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Type        string `json:"type,omitempty"`
+	Position    int    `json:"position,omitempty"`
+}
+
+// ListOnDemandSeasonOptions specifies the optional parameters to the
+// OnDemandService.ListSeason method.
+type ListOnDemandSeasonOptions struct {
+	Filter    string `url:"filter,omitempty"`
+	Sort      string `url:"sort,omitempty"`
+	Direction string `url:"direction,omitempty"`
+	ListOptions
+}
+
+var onDemandFilters = map[string]bool{"published": true, "draft": true}
+
+// List lists the on demand pages of user. Set Filter in opt to "published"
+// or "draft" to list only pages in that state; by default all pages are listed.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/ondemand/pages
+func (s *OnDemandService) List(ctx context.Context, uid string, opt *ListOnDemandOptions) ([]*OnDemand, *Response, error) {
+	u, err := userPath(uid, "ondemand/pages")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if opt != nil && opt.Filter != "" && !onDemandFilters[opt.Filter] {
+		return nil, nil, fmt.Errorf("invalid on demand filter %q", opt.Filter)
+	}
+
+	u, err = addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pages := &dataListOnDemand{}
+
+	resp, err := s.client.Do(ctx, req, pages)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.setPaging(pages)
+
+	return pages.Data, resp, err
+}
+
+func (s *OnDemandService) do(ctx context.Context, method string, u string, body interface{}) (*OnDemand, *Response, error) {
+	req, err := s.client.NewRequest(method, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := &OnDemand{}
+
+	resp, err := s.client.Do(ctx, req, page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return page, resp, err
+}
+
+// Create creates a new on demand page.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/ondemand/pages
+func (s *OnDemandService) Create(ctx context.Context, uid string, r *OnDemandRequest) (*OnDemand, *Response, error) {
+	u, err := userPath(uid, "ondemand/pages")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.do(ctx, "POST", u, r)
+}
+
+// Get returns specific on demand page by ID.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/ondemand/pages/%7Bondemand_id%7D
+func (s *OnDemandService) Get(ctx context.Context, od string) (*OnDemand, *Response, error) {
+	u := fmt.Sprintf("ondemand/pages/%s", od)
+	return s.do(ctx, "GET", u, nil)
+}
+
+// Edit edits specific on demand page by ID.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/ondemand/pages/%7Bondemand_id%7D
+func (s *OnDemandService) Edit(ctx context.Context, od string, r *OnDemandRequest) (*OnDemand, *Response, error) {
+	u := fmt.Sprintf("ondemand/pages/%s", od)
+	return s.do(ctx, "PATCH", u, r)
+}
+
+// Delete deletes specific on demand page by ID.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/ondemand/pages/%7Bondemand_id%7D
+func (s *OnDemandService) Delete(ctx context.Context, od string) (*Response, error) {
+	u := fmt.Sprintf("ondemand/pages/%s", od)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListVideo lists the videos of the on demand page.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/ondemand/pages/%7Bondemand_id%7D/videos
+func (s *OnDemandService) ListVideo(ctx context.Context, od string, opt *ListVideoOptions) ([]*Video, *Response, error) {
+	u := fmt.Sprintf("ondemand/pages/%s/videos", od)
+	videos, resp, err := listVideo(ctx, s.client, u, opt)
+
+	return videos, resp, err
+}
+
+// AddVideo adds specific video by ID to the on demand page, r may be nil.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/ondemand/pages/%7Bondemand_id%7D/videos/%7Bvideo_id%7D
+func (s *OnDemandService) AddVideo(ctx context.Context, od string, vid int, r *OnDemandVideoRequest) (*Response, error) {
+	var body interface{}
+	if r != nil {
+		body = r
+	}
+
+	u := fmt.Sprintf("ondemand/pages/%s/videos/%d", od, vid)
+	req, err := s.client.NewRequest("PUT", u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// RemoveVideo removes specific video by ID from the on demand page.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/ondemand/pages/%7Bondemand_id%7D/videos/%7Bvideo_id%7D
+func (s *OnDemandService) RemoveVideo(ctx context.Context, od string, vid int) (*Response, error) {
+	u := fmt.Sprintf("ondemand/pages/%s/videos/%d", od, vid)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListSeason lists the seasons of the on demand series.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/ondemand/pages/%7Bondemand_id%7D/seasons
+func (s *OnDemandService) ListSeason(ctx context.Context, od string, opt *ListOnDemandSeasonOptions) ([]*OnDemandSeason, *Response, error) {
+	u, err := addOptions(fmt.Sprintf("ondemand/pages/%s/seasons", od), opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	seasons := &dataListOnDemandSeason{}
+
+	resp, err := s.client.Do(ctx, req, seasons)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.setPaging(seasons)
+
+	return seasons.Data, resp, err
+}
+
+// GetSeason returns specific season of the on demand series by ID.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/ondemand/pages/%7Bondemand_id%7D/seasons/%7Bseason_id%7D
+func (s *OnDemandService) GetSeason(ctx context.Context, od string, sn string) (*OnDemandSeason, *Response, error) {
+	u := fmt.Sprintf("ondemand/pages/%s/seasons/%s", od, sn)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	season := &OnDemandSeason{}
+
+	resp, err := s.client.Do(ctx, req, season)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return season, resp, err
+}
+
+// ListSeasonVideo lists the videos of the season of the on demand series.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/ondemand/pages/%7Bondemand_id%7D/seasons/%7Bseason_id%7D/videos
+func (s *OnDemandService) ListSeasonVideo(ctx context.Context, od string, sn string, opt *ListVideoOptions) ([]*Video, *Response, error) {
+	u := fmt.Sprintf("ondemand/pages/%s/seasons/%s/videos", od, sn)
+	videos, resp, err := listVideo(ctx, s.client, u, opt)
+
+	return videos, resp, err
+}
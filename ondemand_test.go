@@ -0,0 +1,284 @@
+package vimeo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestOnDemandService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/ondemand/pages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"page":     "1",
+			"per_page": "2",
+		})
+		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
+	})
+
+	opt := &ListOnDemandOptions{
+		ListOptions: ListOptions{Page: 1, PerPage: 2},
+	}
+	pages, _, err := client.OnDemand.List(context.Background(), "1", opt)
+	if err != nil {
+		t.Errorf("OnDemand.List returned unexpected error: %v", err)
+	}
+
+	want := []*OnDemand{{Name: "Test"}}
+	if !reflect.DeepEqual(pages, want) {
+		t.Errorf("OnDemand.List returned %+v, want %+v", pages, want)
+	}
+}
+
+func TestOnDemandService_List_authenticatedUser(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/ondemand/pages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"filter": "published",
+		})
+		fmt.Fprint(w, `{"data": [{"name": "Test", "published": {"enabled": true}}]}`)
+	})
+
+	opt := &ListOnDemandOptions{Filter: "published"}
+	pages, _, err := client.OnDemand.List(context.Background(), "", opt)
+	if err != nil {
+		t.Errorf("OnDemand.List returned unexpected error: %v", err)
+	}
+
+	want := []*OnDemand{{Name: "Test", Published: &OnDemandPublished{Enabled: true}}}
+	if !reflect.DeepEqual(pages, want) {
+		t.Errorf("OnDemand.List returned %+v, want %+v", pages, want)
+	}
+}
+
+func TestOnDemandService_List_invalidFilter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, _, err := client.OnDemand.List(context.Background(), "", &ListOnDemandOptions{Filter: "archived"})
+	if err == nil {
+		t.Error("OnDemand.List expected error")
+	}
+}
+
+func TestOnDemandService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &OnDemandRequest{
+		Name: "Test",
+		Type: "film",
+		Buy:  &OnDemandPricing{Active: true, Price: map[string]float64{"USD": 9.99}},
+	}
+
+	mux.HandleFunc("/me/ondemand/pages", func(w http.ResponseWriter, r *http.Request) {
+		v := &OnDemandRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("OnDemand.Create body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"name": "Test", "type": "film", "buy": {"active": true, "price": {"USD": 9.99}}}`)
+	})
+
+	page, _, err := client.OnDemand.Create(context.Background(), "", input)
+	if err != nil {
+		t.Errorf("OnDemand.Create returned unexpected error: %v", err)
+	}
+
+	want := &OnDemand{Name: "Test", Type: "film", Buy: input.Buy}
+	if !reflect.DeepEqual(page, want) {
+		t.Errorf("OnDemand.Create returned %+v, want %+v", page, want)
+	}
+}
+
+func TestOnDemandService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/ondemand/pages/od", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name": "Test"}`)
+	})
+
+	page, _, err := client.OnDemand.Get(context.Background(), "od")
+	if err != nil {
+		t.Errorf("OnDemand.Get returned unexpected error: %v", err)
+	}
+
+	want := &OnDemand{Name: "Test"}
+	if !reflect.DeepEqual(page, want) {
+		t.Errorf("OnDemand.Get returned %+v, want %+v", page, want)
+	}
+}
+
+func TestOnDemandService_Edit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &OnDemandRequest{Name: "Test"}
+
+	mux.HandleFunc("/ondemand/pages/od", func(w http.ResponseWriter, r *http.Request) {
+		v := &OnDemandRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PATCH")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("OnDemand.Edit body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"name": "Test"}`)
+	})
+
+	page, _, err := client.OnDemand.Edit(context.Background(), "od", input)
+	if err != nil {
+		t.Errorf("OnDemand.Edit returned unexpected error: %v", err)
+	}
+
+	want := &OnDemand{Name: "Test"}
+	if !reflect.DeepEqual(page, want) {
+		t.Errorf("OnDemand.Edit returned %+v, want %+v", page, want)
+	}
+}
+
+func TestOnDemandService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/ondemand/pages/od", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.OnDemand.Delete(context.Background(), "od")
+	if err != nil {
+		t.Errorf("OnDemand.Delete returned unexpected error: %v", err)
+	}
+}
+
+func TestOnDemandService_ListVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/ondemand/pages/od/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
+	})
+
+	videos, _, err := client.OnDemand.ListVideo(context.Background(), "od", nil)
+	if err != nil {
+		t.Errorf("OnDemand.ListVideo returned unexpected error: %v", err)
+	}
+
+	want := []*Video{{Name: "Test"}}
+	if !reflect.DeepEqual(videos, want) {
+		t.Errorf("OnDemand.ListVideo returned %+v, want %+v", videos, want)
+	}
+}
+
+func TestOnDemandService_AddVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &OnDemandVideoRequest{Type: "trailer"}
+
+	mux.HandleFunc("/ondemand/pages/od/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		v := &OnDemandVideoRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PUT")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("OnDemand.AddVideo body is %+v, want %+v", v, input)
+		}
+	})
+
+	_, err := client.OnDemand.AddVideo(context.Background(), "od", 1, input)
+	if err != nil {
+		t.Errorf("OnDemand.AddVideo returned unexpected error: %v", err)
+	}
+}
+
+func TestOnDemandService_RemoveVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/ondemand/pages/od/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.OnDemand.RemoveVideo(context.Background(), "od", 1)
+	if err != nil {
+		t.Errorf("OnDemand.RemoveVideo returned unexpected error: %v", err)
+	}
+}
+
+func TestOnDemandService_ListSeason(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/ondemand/pages/od/seasons", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"sort": "manual"})
+		fmt.Fprint(w, `{"data": [{"name": "Season 1", "position": 1}]}`)
+	})
+
+	seasons, _, err := client.OnDemand.ListSeason(context.Background(), "od", &ListOnDemandSeasonOptions{Sort: "manual"})
+	if err != nil {
+		t.Errorf("OnDemand.ListSeason returned unexpected error: %v", err)
+	}
+
+	want := []*OnDemandSeason{{Name: "Season 1", Position: 1}}
+	if !reflect.DeepEqual(seasons, want) {
+		t.Errorf("OnDemand.ListSeason returned %+v, want %+v", seasons, want)
+	}
+}
+
+func TestOnDemandService_GetSeason(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/ondemand/pages/od/seasons/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name": "Season 1"}`)
+	})
+
+	season, _, err := client.OnDemand.GetSeason(context.Background(), "od", "1")
+	if err != nil {
+		t.Errorf("OnDemand.GetSeason returned unexpected error: %v", err)
+	}
+
+	want := &OnDemandSeason{Name: "Season 1"}
+	if !reflect.DeepEqual(season, want) {
+		t.Errorf("OnDemand.GetSeason returned %+v, want %+v", season, want)
+	}
+}
+
+func TestOnDemandService_ListSeasonVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/ondemand/pages/od/seasons/1/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
+	})
+
+	videos, _, err := client.OnDemand.ListSeasonVideo(context.Background(), "od", "1", nil)
+	if err != nil {
+		t.Errorf("OnDemand.ListSeasonVideo returned unexpected error: %v", err)
+	}
+
+	want := []*Video{{Name: "Test"}}
+	if !reflect.DeepEqual(videos, want) {
+		t.Errorf("OnDemand.ListSeasonVideo returned %+v, want %+v", videos, want)
+	}
+}
@@ -0,0 +1,49 @@
+package vimeo
+
+import "context"
+
+// PortfoliosService handles communication with the portfolios related
+// methods of the Vimeo API.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/portfolios
+type PortfoliosService service
+
+// List lists the portfolios of the user.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/portfolios
+func (s *PortfoliosService) List(ctx context.Context, uid string, opt *ListPortfolioOptions) ([]*Portfolio, *Response, error) {
+	return s.client.Users.ListPortfolio(ctx, uid, opt)
+}
+
+// Get returns specific portfolio by ID.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/portfolios/%7Bportfolio_id%7D
+func (s *PortfoliosService) Get(ctx context.Context, uid string, p string) (*Portfolio, *Response, error) {
+	return s.client.Users.GetProtfolio(ctx, uid, p)
+}
+
+// ListVideo lists the videos of the portfolio.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/portfolios/%7Bportfolio_id%7D/videos
+func (s *PortfoliosService) ListVideo(ctx context.Context, uid string, p string, opt *ListVideoOptions) ([]*Video, *Response, error) {
+	return s.client.Users.ProtfolioListVideo(ctx, uid, p, opt)
+}
+
+// AddVideo adds specific video by ID to the portfolio.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/portfolios/%7Bportfolio_id%7D/videos/%7Bvideo_id%7D
+func (s *PortfoliosService) AddVideo(ctx context.Context, uid string, p string, vid int) (*Response, error) {
+	return s.client.Users.ProtfolioAddVideo(ctx, uid, p, vid)
+}
+
+// RemoveVideo removes specific video by ID from the portfolio.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/portfolios/%7Bportfolio_id%7D/videos/%7Bvideo_id%7D
+func (s *PortfoliosService) RemoveVideo(ctx context.Context, uid string, p string, vid int) (*Response, error) {
+	return s.client.Users.ProtfolioDeleteVideo(ctx, uid, p, vid)
+}
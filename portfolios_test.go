@@ -0,0 +1,98 @@
+package vimeo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestPortfoliosService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/portfolios", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"name": "Test", "sort": "manual"}]}`)
+	})
+
+	portfolios, _, err := client.Portfolios.List(context.Background(), "1", nil)
+	if err != nil {
+		t.Errorf("Portfolios.List returned unexpected error: %v", err)
+	}
+
+	want := []*Portfolio{{Name: "Test", Sort: "manual"}}
+	if !reflect.DeepEqual(portfolios, want) {
+		t.Errorf("Portfolios.List returned %+v, want %+v", portfolios, want)
+	}
+}
+
+func TestPortfoliosService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/portfolios/p", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name": "Test"}`)
+	})
+
+	portfolio, _, err := client.Portfolios.Get(context.Background(), "", "p")
+	if err != nil {
+		t.Errorf("Portfolios.Get returned unexpected error: %v", err)
+	}
+
+	want := &Portfolio{Name: "Test"}
+	if !reflect.DeepEqual(portfolio, want) {
+		t.Errorf("Portfolios.Get returned %+v, want %+v", portfolio, want)
+	}
+}
+
+func TestPortfoliosService_ListVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/portfolios/p/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"sort": "date"})
+		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
+	})
+
+	videos, _, err := client.Portfolios.ListVideo(context.Background(), "1", "p", &ListVideoOptions{Sort: "date"})
+	if err != nil {
+		t.Errorf("Portfolios.ListVideo returned unexpected error: %v", err)
+	}
+
+	want := []*Video{{Name: "Test"}}
+	if !reflect.DeepEqual(videos, want) {
+		t.Errorf("Portfolios.ListVideo returned %+v, want %+v", videos, want)
+	}
+}
+
+func TestPortfoliosService_AddVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/portfolios/p/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+	})
+
+	_, err := client.Portfolios.AddVideo(context.Background(), "1", "p", 1)
+	if err != nil {
+		t.Errorf("Portfolios.AddVideo returned unexpected error: %v", err)
+	}
+}
+
+func TestPortfoliosService_RemoveVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/portfolios/p/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Portfolios.RemoveVideo(context.Background(), "1", "p", 1)
+	if err != nil {
+		t.Errorf("Portfolios.RemoveVideo returned unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,33 @@
+package vimeo
+
+import "context"
+
+// PresetsService handles communication with the embed presets related
+// methods of the Vimeo API.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/embed-presets
+type PresetsService service
+
+// List lists the embed presets of the user.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/presets
+func (s *PresetsService) List(ctx context.Context, uid string, opt *ListPresetOptions) ([]*Preset, *Response, error) {
+	return s.client.Users.ListPreset(ctx, uid, opt)
+}
+
+// Get returns specific embed preset by ID.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/presets/%7Bpreset_id%7D
+func (s *PresetsService) Get(ctx context.Context, uid string, p int) (*Preset, *Response, error) {
+	return s.client.Users.GetPreset(ctx, uid, p)
+}
+
+// ListVideo lists the videos the embed preset is assigned to.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/presets/%7Bpreset_id%7D/videos
+func (s *PresetsService) ListVideo(ctx context.Context, uid string, p int, opt *ListVideoOptions) ([]*Video, *Response, error) {
+	return s.client.Users.PresetListVideo(ctx, uid, p, opt)
+}
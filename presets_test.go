@@ -0,0 +1,76 @@
+package vimeo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestPresetsService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/presets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
+	})
+
+	presets, _, err := client.Presets.List(context.Background(), "1", nil)
+	if err != nil {
+		t.Errorf("Presets.List returned unexpected error: %v", err)
+	}
+
+	want := []*Preset{{Name: "Test"}}
+	if !reflect.DeepEqual(presets, want) {
+		t.Errorf("Presets.List returned %+v, want %+v", presets, want)
+	}
+}
+
+func TestPresetsService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/presets/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name": "Test", "settings": {"buttons": {"like": true, "share": true}, "logos": {"vimeo": false, "custom": {"active": true, "link": "logo.png"}}, "outro": {"type": "link", "link": "https://example.com"}}}`)
+	})
+
+	preset, _, err := client.Presets.Get(context.Background(), "", 1)
+	if err != nil {
+		t.Errorf("Presets.Get returned unexpected error: %v", err)
+	}
+
+	want := &Preset{
+		Name: "Test",
+		Settings: &PresetSettings{
+			Buttons: &PresetButtons{Like: true, Share: true},
+			Logos:   &PresetLogos{Custom: &PresetCustomLogo{Active: true, Link: "logo.png"}},
+			Outro:   &PresetOutro{Type: "link", Link: "https://example.com"},
+		},
+	}
+	if !reflect.DeepEqual(preset, want) {
+		t.Errorf("Presets.Get returned %+v, want %+v", preset, want)
+	}
+}
+
+func TestPresetsService_ListVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/presets/1/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
+	})
+
+	videos, _, err := client.Presets.ListVideo(context.Background(), "1", 1, nil)
+	if err != nil {
+		t.Errorf("Presets.ListVideo returned unexpected error: %v", err)
+	}
+
+	want := []*Video{{Name: "Test"}}
+	if !reflect.DeepEqual(videos, want) {
+		t.Errorf("Presets.ListVideo returned %+v, want %+v", videos, want)
+	}
+}
@@ -0,0 +1,370 @@
+package vimeo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ProjectsService handles communication with the projects (folders) related
+// methods of the Vimeo API.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/reference/folders
+type ProjectsService service
+
+// Project represents a project (folder).
+type Project struct {
+	URI          string    `json:"uri,omitempty"`
+	Name         string    `json:"name,omitempty"`
+	CreatedTime  Timestamp `json:"created_time,omitempty"`
+	ModifiedTime Timestamp `json:"modified_time,omitempty"`
+	User         *User     `json:"user,omitempty"`
+	ParentFolder *Project  `json:"parent_folder,omitempty"`
+	ResourceKey  string    `json:"resource_key,omitempty"`
+}
+
+type dataListProject struct {
+	Data []*Project `json:"data"`
+	pagination
+}
+
+// ProjectRequest represents a request to create/edit a project.
+type ProjectRequest struct {
+	Name            string `json:"name,omitempty"`
+	ParentFolderURI string `json:"parent_folder_uri,omitempty"`
+}
+
+// ListProjectOptions specifies the optional parameters to the
+// ProjectsService.List method.
+type ListProjectOptions struct {
+	Query     string `url:"query,omitempty"`
+	Sort      string `url:"sort,omitempty"`
+	Direction string `url:"direction,omitempty"`
+	ListOptions
+}
+
+func listProject(ctx context.Context, c *Client, url string, opt *ListProjectOptions) ([]*Project, *Response, error) {
+	u, err := addOptions(url, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	projects := &dataListProject{}
+
+	resp, err := c.Do(ctx, req, projects)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.setPaging(projects)
+
+	return projects.Data, resp, err
+}
+
+func getProject(ctx context.Context, c *Client, url string) (*Project, *Response, error) {
+	return doProject(ctx, c, "GET", url, nil)
+}
+
+func doProject(ctx context.Context, c *Client, method string, url string, r *ProjectRequest) (*Project, *Response, error) {
+	var body interface{}
+	if r != nil {
+		body = r
+	}
+
+	req, err := c.NewRequest(method, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	project := &Project{}
+
+	resp, err := c.Do(ctx, req, project)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return project, resp, err
+}
+
+// projectURL returns the path of the project p of the user. The project may
+// be passed as an ID or as a "/users/{user_id}/projects/{project_id}" or
+// "/me/projects/{project_id}" URI, whose user replaces uid.
+func projectURL(uid string, p string) (string, error) {
+	if strings.Contains(p, "/") {
+		parts := strings.Split(strings.TrimPrefix(p, "/"), "/")
+		switch {
+		case len(parts) == 3 && parts[0] == "me" && parts[1] == "projects":
+			uid, p = "", parts[2]
+		case len(parts) == 4 && parts[0] == "users" && parts[1] != "" && parts[2] == "projects":
+			uid, p = parts[1], parts[3]
+		default:
+			return "", fmt.Errorf("invalid project %q", p)
+		}
+	}
+
+	if p == "" || sanitizeID(p) != nil {
+		return "", fmt.Errorf("invalid project %q", p)
+	}
+
+	return userPath(uid, "projects/%s", p)
+}
+
+// List lists the projects.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/projects
+func (s *ProjectsService) List(ctx context.Context, uid string, opt *ListProjectOptions) ([]*Project, *Response, error) {
+	u, err := userPath(uid, "projects")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	projects, resp, err := listProject(ctx, s.client, u, opt)
+
+	return projects, resp, err
+}
+
+// Get specific project by ID.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/projects/%7Bproject_id%7D
+func (s *ProjectsService) Get(ctx context.Context, uid string, p string) (*Project, *Response, error) {
+	u, err := projectURL(uid, p)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	project, resp, err := getProject(ctx, s.client, u)
+
+	return project, resp, err
+}
+
+// ListVideo lists the video for an project.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/projects/%7Bproject_id%7D/videos
+func (s *ProjectsService) ListVideo(ctx context.Context, uid string, p string, opt *ListVideoOptions) ([]*Video, *Response, error) {
+	u, err := projectURL(uid, p)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	videos, resp, err := listVideo(ctx, s.client, u+"/videos", opt)
+
+	return videos, resp, err
+}
+
+// Create a new project.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/projects
+func (s *ProjectsService) Create(ctx context.Context, uid string, r *ProjectRequest) (*Project, *Response, error) {
+	u, err := userPath(uid, "projects")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	project, resp, err := doProject(ctx, s.client, "POST", u, r)
+
+	return project, resp, err
+}
+
+// Edit specific project by ID.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/projects/%7Bproject_id%7D
+func (s *ProjectsService) Edit(ctx context.Context, uid string, p string, r *ProjectRequest) (*Project, *Response, error) {
+	u, err := projectURL(uid, p)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	project, resp, err := doProject(ctx, s.client, "PATCH", u, r)
+
+	return project, resp, err
+}
+
+// Delete specific project by ID. The videos of the project are kept.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/projects/%7Bproject_id%7D
+func (s *ProjectsService) Delete(ctx context.Context, uid string, p string) (*Response, error) {
+	u, err := projectURL(uid, p)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// AddVideo add specific video by project ID and video ID. A video belongs
+// to one project only, so this also moves it out of its current project.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/projects/%7Bproject_id%7D/videos/%7Bvideo_id%7D
+func (s *ProjectsService) AddVideo(ctx context.Context, uid string, p string, vid int) (*Response, error) {
+	u, err := projectURL(uid, p)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest("PUT", fmt.Sprintf("%s/videos/%d", u, vid), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// RemoveVideo remove specific video by project ID and video ID.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/projects/%7Bproject_id%7D/videos/%7Bvideo_id%7D
+func (s *ProjectsService) RemoveVideo(ctx context.Context, uid string, p string, vid int) (*Response, error) {
+	u, err := projectURL(uid, p)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest("DELETE", fmt.Sprintf("%s/videos/%d", u, vid), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// walkPages calls list with consecutive pages until the last page.
+func walkPages(list func(opt ListOptions) (*Response, error)) error {
+	opt := ListOptions{Page: 1, PerPage: 100}
+	for {
+		resp, err := list(opt)
+		if err != nil {
+			return err
+		}
+
+		if resp.NextPage == "" {
+			return nil
+		}
+		opt.Page++
+	}
+}
+
+// WalkAllVideos calls fn for every video of user together with the project
+// containing it, whatever the folder nesting depth. Videos outside of any
+// folder are passed with a nil project. Each folder and video is visited
+// once, so cycles in the folder hierarchy are harmless. Walking stops at the
+// first error returned by fn.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/projects
+func (s *ProjectsService) WalkAllVideos(ctx context.Context, uid string, fn func(*Video, *Project) error) error {
+	var folders []*Project
+	err := walkPages(func(opt ListOptions) (*Response, error) {
+		projects, resp, err := s.List(ctx, uid, &ListProjectOptions{ListOptions: opt})
+		folders = append(folders, projects...)
+		return resp, err
+	})
+	if err != nil {
+		return err
+	}
+
+	seenFolders := make(map[string]bool)
+	seenVideos := make(map[string]bool)
+
+	visit := func(videos []*Video, project *Project) error {
+		for _, v := range videos {
+			if seenVideos[v.URI] {
+				continue
+			}
+			seenVideos[v.URI] = true
+
+			if err := fn(v, project); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, project := range folders {
+		if seenFolders[project.URI] {
+			continue
+		}
+		seenFolders[project.URI] = true
+
+		err := walkPages(func(opt ListOptions) (*Response, error) {
+			videos, resp, err := s.ListVideo(ctx, uid, project.URI, &ListVideoOptions{ListOptions: opt})
+			if err != nil {
+				return resp, err
+			}
+			return resp, visit(videos, project)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return walkPages(func(opt ListOptions) (*Response, error) {
+		videos, resp, err := s.client.Users.ListVideo(ctx, uid, &ListVideoOptions{ListOptions: opt})
+		if err != nil {
+			return resp, err
+		}
+
+		var root []*Video
+		for _, v := range videos {
+			if v.ParentFolder == nil {
+				root = append(root, v)
+			}
+		}
+		return resp, visit(root, nil)
+	})
+}
+
+// Breadcrumb returns the folder path from the root down to the given folder,
+// following the parent folder of each project. The folder may be passed as
+// an ID or as a full URI (such as Video.ParentFolder.URI). The empty folder
+// stands for the root and results in an empty path.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/projects/%7Bproject_id%7D
+func (s *ProjectsService) Breadcrumb(ctx context.Context, uid string, folder string) ([]*Project, error) {
+	path := []*Project{}
+	seen := make(map[string]bool)
+
+	for u := folder; u != ""; {
+		var err error
+		if u, err = projectURL(uid, u); err != nil {
+			return nil, err
+		}
+
+		if seen[u] {
+			return nil, errors.New("the folder hierarchy contains a cycle")
+		}
+		seen[u] = true
+
+		project, _, err := getProject(ctx, s.client, u)
+		if err != nil {
+			return nil, err
+		}
+
+		path = append([]*Project{project}, path...)
+
+		if project.ParentFolder == nil {
+			break
+		}
+		u = project.ParentFolder.URI
+	}
+
+	return path, nil
+}
@@ -0,0 +1,365 @@
+package vimeo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestProjectsService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/projects/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name": "Test"}`)
+	})
+
+	project, _, err := client.Projects.Get(context.Background(), "1", "2")
+	if err != nil {
+		t.Errorf("Projects.Get returned unexpected error: %v", err)
+	}
+
+	want := &Project{Name: "Test"}
+	if !reflect.DeepEqual(project, want) {
+		t.Errorf("Projects.Get returned %+v, want %+v", project, want)
+	}
+}
+
+func TestProjectsService_Get_authenticatedUser(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/projects/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name": "Test"}`)
+	})
+
+	project, _, err := client.Projects.Get(context.Background(), "", "2")
+	if err != nil {
+		t.Errorf("Projects.Get returned unexpected error: %v", err)
+	}
+
+	want := &Project{Name: "Test"}
+	if !reflect.DeepEqual(project, want) {
+		t.Errorf("Projects.Get returned %+v, want %+v", project, want)
+	}
+}
+
+func TestProjectsService_Get_uri(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.BaseURL, _ = url.Parse(server.URL + "/api/")
+
+	mux.HandleFunc("/api/users/1/projects/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name": "Test"}`)
+	})
+
+	for _, uri := range []string{"/users/1/projects/2", "users/1/projects/2"} {
+		project, _, err := client.Projects.Get(context.Background(), "", uri)
+		if err != nil {
+			t.Errorf("Projects.Get(%q) returned unexpected error: %v", uri, err)
+		}
+
+		if want := (&Project{Name: "Test"}); !reflect.DeepEqual(project, want) {
+			t.Errorf("Projects.Get(%q) returned %+v, want %+v", uri, project, want)
+		}
+	}
+}
+
+func TestProjectsService_Get_invalidProject(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request to %v", r.URL)
+	})
+
+	for _, p := range []string{"../../videos/5", "/videos/5", "/users/1/projects/../2", "/users/1/albums/2", "..", ""} {
+		if _, _, err := client.Projects.Get(context.Background(), "1", p); err == nil {
+			t.Errorf("Projects.Get(%q) expected error to be returned", p)
+		}
+	}
+}
+
+func TestProjectsService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"page":     "1",
+			"per_page": "2",
+		})
+		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
+	})
+
+	opt := &ListProjectOptions{
+		ListOptions: ListOptions{Page: 1, PerPage: 2},
+	}
+	projects, _, err := client.Projects.List(context.Background(), "1", opt)
+	if err != nil {
+		t.Errorf("Projects.List returned unexpected error: %v", err)
+	}
+
+	want := []*Project{{Name: "Test"}}
+	if !reflect.DeepEqual(projects, want) {
+		t.Errorf("Projects.List returned %+v, want %+v", projects, want)
+	}
+}
+
+func TestProjectsService_ListVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/projects/2/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
+	})
+
+	videos, _, err := client.Projects.ListVideo(context.Background(), "", "2", nil)
+	if err != nil {
+		t.Errorf("Projects.ListVideo returned unexpected error: %v", err)
+	}
+
+	want := []*Video{{Name: "Test"}}
+	if !reflect.DeepEqual(videos, want) {
+		t.Errorf("Projects.ListVideo returned %+v, want %+v", videos, want)
+	}
+}
+
+func TestProjectsService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &ProjectRequest{
+		Name:            "name",
+		ParentFolderURI: "/users/1/projects/2",
+	}
+
+	mux.HandleFunc("/me/projects", func(w http.ResponseWriter, r *http.Request) {
+		v := &ProjectRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Projects.Create body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"name": "name"}`)
+	})
+
+	project, _, err := client.Projects.Create(context.Background(), "", input)
+	if err != nil {
+		t.Errorf("Projects.Create returned unexpected error: %v", err)
+	}
+
+	want := &Project{Name: "name"}
+	if !reflect.DeepEqual(project, want) {
+		t.Errorf("Projects.Create returned %+v, want %+v", project, want)
+	}
+}
+
+func TestProjectsService_Edit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &ProjectRequest{
+		Name: "name",
+	}
+
+	mux.HandleFunc("/users/1/projects/2", func(w http.ResponseWriter, r *http.Request) {
+		v := &ProjectRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PATCH")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Projects.Edit body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"name": "name"}`)
+	})
+
+	project, _, err := client.Projects.Edit(context.Background(), "1", "2", input)
+	if err != nil {
+		t.Errorf("Projects.Edit returned unexpected error: %v", err)
+	}
+
+	want := &Project{Name: "name"}
+	if !reflect.DeepEqual(project, want) {
+		t.Errorf("Projects.Edit returned %+v, want %+v", project, want)
+	}
+}
+
+func TestProjectsService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/projects/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Projects.Delete(context.Background(), "1", "2")
+	if err != nil {
+		t.Errorf("Projects.Delete returned unexpected error: %v", err)
+	}
+}
+
+func TestProjectsService_Delete_invalidID(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request to %v", r.URL)
+	})
+
+	if _, err := client.Projects.Delete(context.Background(), "../me", "2"); err == nil {
+		t.Error("Projects.Delete expected error to be returned")
+	}
+}
+
+func TestProjectsService_AddVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/projects/2/videos/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+	})
+
+	_, err := client.Projects.AddVideo(context.Background(), "", "2", 3)
+	if err != nil {
+		t.Errorf("Projects.AddVideo returned unexpected error: %v", err)
+	}
+}
+
+func TestProjectsService_RemoveVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/projects/2/videos/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Projects.RemoveVideo(context.Background(), "1", "2", 3)
+	if err != nil {
+		t.Errorf("Projects.RemoveVideo returned unexpected error: %v", err)
+	}
+}
+
+func TestProjectsService_WalkAllVideos(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "1":
+			fmt.Fprint(w, `{"data": [{"uri": "/users/1/projects/2"}], "paging": {"next": "/users/1/projects?page=2"}}`)
+		default:
+			fmt.Fprint(w, `{"data": [{"uri": "/users/1/projects/3", "parent_folder": {"uri": "/users/1/projects/2"}}, {"uri": "/users/1/projects/2"}]}`)
+		}
+	})
+
+	mux.HandleFunc("/users/1/projects/2/videos", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": [{"uri": "/videos/1"}]}`)
+	})
+
+	mux.HandleFunc("/users/1/projects/3/videos", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": [{"uri": "/videos/2"}]}`)
+	})
+
+	mux.HandleFunc("/users/1/videos", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": [
+			{"uri": "/videos/1", "parent_folder": {"uri": "/users/1/projects/2"}},
+			{"uri": "/videos/3"}
+		]}`)
+	})
+
+	got := make(map[string]string)
+	err := client.Projects.WalkAllVideos(context.Background(), "1", func(v *Video, p *Project) error {
+		if p == nil {
+			got[v.URI] = ""
+		} else {
+			got[v.URI] = p.URI
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Projects.WalkAllVideos returned unexpected error: %v", err)
+	}
+
+	want := map[string]string{
+		"/videos/1": "/users/1/projects/2",
+		"/videos/2": "/users/1/projects/3",
+		"/videos/3": "",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Projects.WalkAllVideos visited %+v, want %+v", got, want)
+	}
+}
+
+func TestProjectsService_Breadcrumb(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/projects/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"uri": "/users/1/projects/3", "name": "Nature", "parent_folder": {"uri": "/users/1/projects/2"}}`)
+	})
+
+	mux.HandleFunc("/users/1/projects/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"uri": "/users/1/projects/2", "name": "Documentary"}`)
+	})
+
+	path, err := client.Projects.Breadcrumb(context.Background(), "1", "/users/1/projects/3")
+	if err != nil {
+		t.Errorf("Projects.Breadcrumb returned unexpected error: %v", err)
+	}
+
+	want := []*Project{
+		{URI: "/users/1/projects/2", Name: "Documentary"},
+		{URI: "/users/1/projects/3", Name: "Nature", ParentFolder: &Project{URI: "/users/1/projects/2"}},
+	}
+	if !reflect.DeepEqual(path, want) {
+		t.Errorf("Projects.Breadcrumb returned %+v, want %+v", path, want)
+	}
+}
+
+func TestProjectsService_Breadcrumb_root(t *testing.T) {
+	setup()
+	defer teardown()
+
+	path, err := client.Projects.Breadcrumb(context.Background(), "1", "")
+	if err != nil {
+		t.Errorf("Projects.Breadcrumb returned unexpected error: %v", err)
+	}
+
+	if want := []*Project{}; !reflect.DeepEqual(path, want) {
+		t.Errorf("Projects.Breadcrumb returned %+v, want %+v", path, want)
+	}
+}
+
+func TestProjectsService_Breadcrumb_cycle(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/projects/2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"uri": "/users/1/projects/2", "parent_folder": {"uri": "/users/1/projects/3"}}`)
+	})
+
+	mux.HandleFunc("/users/1/projects/3", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"uri": "/users/1/projects/3", "parent_folder": {"uri": "/users/1/projects/2"}}`)
+	})
+
+	if _, err := client.Projects.Breadcrumb(context.Background(), "1", "2"); err == nil {
+		t.Error("Projects.Breadcrumb expected error")
+	}
+}
@@ -0,0 +1,211 @@
+package vimeo
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultRetryWaitMin = 1 * time.Second
+	defaultRetryWaitMax = 30 * time.Second
+)
+
+// send sends the request, retrying it up to RetryMax times. The response
+// of the last attempt is returned.
+func (c *Client) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		if err := c.waitRate(ctx); err != nil {
+			return nil, err
+		}
+
+		if c.Limiter != nil {
+			if err := c.Limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		start := time.Now()
+		resp, err := c.client.Do(req)
+		c.logResponse(req, resp, start, err)
+		if resp != nil {
+			c.setRate(parseRate(resp))
+		}
+		if err != nil {
+			// If we got an error, and the context has been canceled,
+			// the context's error is probably more useful.
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			default:
+			}
+
+			if !c.canRetry(req, attempt) {
+				return nil, err
+			}
+		} else if !c.canRetryStatus(req, attempt, resp.StatusCode) {
+			return resp, nil
+		}
+
+		wait := c.backoff(attempt, resp)
+		if resp != nil {
+			io.CopyN(ioutil.Discard, resp.Body, 512)
+			resp.Body.Close()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// Rate returns the rate limit sent with the most recent response, without
+// a call to the API. It's zero until a response with the rate limit headers
+// is received.
+func (c *Client) Rate() Rate {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.rate
+}
+
+func (c *Client) setRate(rate Rate) {
+	if rate.Limit == 0 && rate.Reset.IsZero() {
+		return
+	}
+
+	c.mu.Lock()
+	c.rate = rate
+	c.mu.Unlock()
+}
+
+// waitRate waits until the rate limit resets, if RateLimitBlock is set and
+// the limit is exhausted, or until the context is done.
+func (c *Client) waitRate(ctx context.Context) error {
+	if !c.RateLimitBlock {
+		return nil
+	}
+
+	rate := c.Rate()
+	if rate.Limit == 0 || rate.Remaining > 0 {
+		return nil
+	}
+	wait := time.Until(rate.Reset)
+	if wait <= 0 {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(wait):
+		return nil
+	}
+}
+
+// canRetry reports whether the request can be sent once more.
+func (c *Client) canRetry(req *http.Request, attempt int) bool {
+	if attempt >= c.RetryMax {
+		return false
+	}
+
+	// The body of the previous attempt has been consumed.
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// withRateLimitRetries returns a copy of ctx whose requests are retried at
+// least n times when rate limited, whatever Client.RetryMax.
+func withRateLimitRetries(ctx context.Context, n int) context.Context {
+	return context.WithValue(ctx, rateLimitRetriesKey, n)
+}
+
+// canRetryStatus reports whether the request can be sent once more after a
+// response with the status code.
+func (c *Client) canRetryStatus(req *http.Request, attempt int, code int) bool {
+	if !c.retryStatus(req, code) {
+		return false
+	}
+
+	if n, _ := req.Context().Value(rateLimitRetriesKey).(int); code == http.StatusTooManyRequests && attempt < n {
+		return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+	}
+
+	return c.canRetry(req, attempt)
+}
+
+// retryStatus reports whether the response status is worth a retry.
+func (c *Client) retryStatus(req *http.Request, code int) bool {
+	if code == http.StatusTooManyRequests {
+		// The request was rejected before being processed.
+		return true
+	}
+
+	if code < 500 || code == http.StatusNotImplemented {
+		return false
+	}
+
+	switch req.Method {
+	case "POST", "PATCH":
+		return c.RetryNonIdempotent
+	}
+
+	return true
+}
+
+// backoff returns the delay before the next attempt: the Retry-After header
+// when present, otherwise an exponential backoff with jitter bounded by
+// RetryWaitMin and RetryWaitMax.
+func (c *Client) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return wait
+		}
+	}
+
+	wait := c.RetryWaitMin << uint(attempt)
+	if wait <= 0 || wait > c.RetryWaitMax {
+		wait = c.RetryWaitMax
+	}
+
+	// Randomize the delay within the upper half of the interval.
+	if half := int64(wait / 2); half > 0 {
+		wait = time.Duration(half + rand.Int63n(half))
+	}
+
+	return wait
+}
+
+// parseRetryAfter parses the Retry-After value given in seconds or as
+// a HTTP date.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+
+	if sec, err := strconv.Atoi(v); err == nil && sec >= 0 {
+		return time.Duration(sec) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		wait := t.Sub(time.Now())
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,317 @@
+package vimeo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestDo_retryStatus(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.RetryMax = 2
+	client.RetryWaitMin = time.Millisecond
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	if _, err := client.Do(context.Background(), req, nil); err != nil {
+		t.Errorf("Do returned unexpected error: %v", err)
+	}
+
+	if calls != 3 {
+		t.Errorf("Do sent %d requests, want 3", calls)
+	}
+}
+
+func TestDo_retryMax(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.RetryMax = 1
+	client.RetryWaitMin = time.Millisecond
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	resp, err := client.Do(context.Background(), req, nil)
+	if err == nil {
+		t.Error("Expected HTTP 429 error.")
+	}
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("Do returned status %d, want %d", resp.StatusCode, http.StatusTooManyRequests)
+	}
+
+	if calls != 2 {
+		t.Errorf("Do sent %d requests, want 2", calls)
+	}
+}
+
+func TestDo_retryBody(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.RetryMax = 1
+	client.RetryWaitMin = time.Millisecond
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := ioutil.ReadAll(r.Body)
+		if want := `{"name":"n"}` + "\n"; string(body) != want {
+			t.Errorf("Request body is %q, want %q", body, want)
+		}
+		if calls == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	})
+
+	req, _ := client.NewRequest("PUT", "/", map[string]string{"name": "n"})
+	if _, err := client.Do(context.Background(), req, nil); err != nil {
+		t.Errorf("Do returned unexpected error: %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("Do sent %d requests, want 2", calls)
+	}
+}
+
+func TestDo_retryNonIdempotent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.RetryMax = 1
+	client.RetryWaitMin = time.Millisecond
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	req, _ := client.NewRequest("POST", "/", nil)
+	client.Do(context.Background(), req, nil)
+
+	if calls != 1 {
+		t.Errorf("Do sent %d POST requests, want 1", calls)
+	}
+
+	client.RetryNonIdempotent = true
+	calls = 0
+
+	req, _ = client.NewRequest("POST", "/", nil)
+	client.Do(context.Background(), req, nil)
+
+	if calls != 2 {
+		t.Errorf("Do sent %d POST requests with RetryNonIdempotent, want 2", calls)
+	}
+}
+
+func TestDo_retryRateLimitedBody(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.RetryMax = 1
+	client.RetryWaitMin = time.Millisecond
+
+	calls := 0
+	mux.HandleFunc("/users/1", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		testMethod(t, r, "PATCH")
+		body, _ := ioutil.ReadAll(r.Body)
+		if want := `{"name":"name"}` + "\n"; string(body) != want {
+			t.Errorf("Request %d body is %q, want %q", calls, body, want)
+		}
+		if calls == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"name": "name"}`)
+	})
+
+	user, _, err := client.Users.Edit(context.Background(), "1", &UserRequest{Name: "name"})
+	if err != nil {
+		t.Errorf("Users.Edit returned unexpected error: %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("Users.Edit sent %d requests, want 2", calls)
+	}
+	if user == nil || user.Name != "name" {
+		t.Errorf("Users.Edit returned %+v, want the user of the second response", user)
+	}
+}
+
+func TestDo_retryContextCanceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.RetryMax = 1
+	client.RetryWaitMin = time.Hour
+	client.RetryWaitMax = time.Hour
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	if _, err := client.Do(ctx, req, nil); err != context.Canceled {
+		t.Errorf("Expected context.Canceled error, got %v", err)
+	}
+}
+
+func TestClient_Rate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if got := client.Rate(); got != (Rate{}) {
+		t.Errorf("Rate returned %+v before any request, want zero", got)
+	}
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "60")
+		w.Header().Set(headerRateRemaining, "59")
+		w.Header().Set(headerRateReset, "1372700873")
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	if _, err := client.Do(context.Background(), req, nil); err != nil {
+		t.Fatalf("Do returned unexpected error: %v", err)
+	}
+
+	want := Rate{Limit: 60, Remaining: 59, Reset: time.Unix(1372700873, 0)}
+	if got := client.Rate(); got != want {
+		t.Errorf("Rate returned %+v, want %+v", got, want)
+	}
+}
+
+func TestDo_rateLimitBlock(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.RateLimitBlock = true
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set(headerRateLimit, "60")
+		w.Header().Set(headerRateRemaining, "0")
+		w.Header().Set(headerRateReset, strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	if _, err := client.Do(context.Background(), req, nil); err != nil {
+		t.Fatalf("Do returned unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	req, _ = client.NewRequest("GET", "/", nil)
+	if _, err := client.Do(ctx, req, nil); err != context.DeadlineExceeded {
+		t.Errorf("Expected context.DeadlineExceeded error, got %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("Do sent %d requests, want 1", calls)
+	}
+}
+
+type countLimiter struct {
+	calls int
+	err   error
+}
+
+func (l *countLimiter) Wait(ctx context.Context) error {
+	l.calls++
+	return l.err
+}
+
+func TestDo_limiter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.RetryMax = 1
+	client.RetryWaitMin = time.Millisecond
+
+	limiter := &countLimiter{}
+	client.Limiter = limiter
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	if _, err := client.Do(context.Background(), req, nil); err != nil {
+		t.Errorf("Do returned unexpected error: %v", err)
+	}
+
+	if limiter.calls != 2 {
+		t.Errorf("Limiter was waited for %d times, want 2", limiter.calls)
+	}
+
+	limiter.err = errors.New("limited")
+	req, _ = client.NewRequest("GET", "/", nil)
+	if _, err := client.Do(context.Background(), req, nil); err != limiter.err {
+		t.Errorf("Do returned error %v, want the limiter error", err)
+	}
+	if calls != 2 {
+		t.Errorf("Do sent %d requests, want 2", calls)
+	}
+}
+
+func TestClient_backoff(t *testing.T) {
+	c := NewClient(nil)
+	c.RetryWaitMin = time.Second
+	c.RetryWaitMax = 4 * time.Second
+
+	for attempt, max := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second} {
+		wait := c.backoff(attempt, nil)
+		if wait < max/2 || wait > max {
+			t.Errorf("backoff(%d) returned %v, want between %v and %v", attempt, wait, max/2, max)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": {"7"}}}
+	if wait := c.backoff(0, resp); wait != 7*time.Second {
+		t.Errorf("backoff returned %v with Retry-After, want 7s", wait)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if wait, ok := parseRetryAfter("3"); !ok || wait != 3*time.Second {
+		t.Errorf("parseRetryAfter returned %v, %v, want 3s, true", wait, ok)
+	}
+
+	date := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+	if wait, ok := parseRetryAfter(date); !ok || wait <= 0 || wait > time.Minute {
+		t.Errorf("parseRetryAfter(%q) returned %v, %v", date, wait, ok)
+	}
+
+	if _, ok := parseRetryAfter("soon"); ok {
+		t.Error("parseRetryAfter returned true for invalid value")
+	}
+}
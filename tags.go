@@ -1,6 +1,10 @@
 package vimeo
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
 
 // TagsService handles communication with the tag related
 // methods of the Vimeo API.
@@ -22,7 +26,7 @@ type Tag struct {
 	ResourceKey string `json:"resource_key,omitempty"`
 }
 
-func listTag(c *Client, url string) ([]*Tag, *Response, error) {
+func listTag(ctx context.Context, c *Client, url string) ([]*Tag, *Response, error) {
 	req, err := c.NewRequest("GET", url, nil)
 	if err != nil {
 		return nil, nil, err
@@ -30,7 +34,7 @@ func listTag(c *Client, url string) ([]*Tag, *Response, error) {
 
 	categories := &dataListTag{}
 
-	resp, err := c.Do(req, categories)
+	resp, err := c.Do(ctx, req, categories)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -40,7 +44,7 @@ func listTag(c *Client, url string) ([]*Tag, *Response, error) {
 	return categories.Data, resp, err
 }
 
-func getTag(c *Client, url string) (*Tag, *Response, error) {
+func getTag(ctx context.Context, c *Client, url string) (*Tag, *Response, error) {
 	req, err := c.NewRequest("GET", url, nil)
 	if err != nil {
 		return nil, nil, err
@@ -48,7 +52,7 @@ func getTag(c *Client, url string) (*Tag, *Response, error) {
 
 	tag := &Tag{}
 
-	resp, err := c.Do(req, tag)
+	resp, err := c.Do(ctx, req, tag)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -59,9 +63,9 @@ func getTag(c *Client, url string) (*Tag, *Response, error) {
 // Get specific tag by name.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/tags/%7Bword%7D
-func (s *TagsService) Get(t string) (*Tag, *Response, error) {
-	u := fmt.Sprintf("tags/%s", t)
-	tag, resp, err := getTag(s.client, u)
+func (s *TagsService) Get(ctx context.Context, t string) (*Tag, *Response, error) {
+	u := fmt.Sprintf("tags/%s", url.PathEscape(t))
+	tag, resp, err := getTag(ctx, s.client, u)
 
 	return tag, resp, err
 }
@@ -69,9 +73,9 @@ func (s *TagsService) Get(t string) (*Tag, *Response, error) {
 // ListVideo lists the video for an tag.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/tags/%7Bword%7D/videos
-func (s *TagsService) ListVideo(t string, opt *ListVideoOptions) ([]*Video, *Response, error) {
-	u := fmt.Sprintf("tags/%s/videos", t)
-	videos, resp, err := listVideo(s.client, u, opt)
+func (s *TagsService) ListVideo(ctx context.Context, t string, opt *ListVideoOptions) ([]*Video, *Response, error) {
+	u := fmt.Sprintf("tags/%s/videos", url.PathEscape(t))
+	videos, resp, err := listVideo(ctx, s.client, u, opt)
 
 	return videos, resp, err
 }
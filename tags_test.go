@@ -1,6 +1,7 @@
 package vimeo
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -16,7 +17,7 @@ func TestTagsService_Get(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	tag, _, err := client.Tags.Get("1")
+	tag, _, err := client.Tags.Get(context.Background(), "1")
 	if err != nil {
 		t.Errorf("Tags.Get returned unexpected error: %v", err)
 	}
@@ -43,7 +44,7 @@ func TestTagsService_ListVideo(t *testing.T) {
 	opt := &ListVideoOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	videos, _, err := client.Tags.ListVideo("1", opt)
+	videos, _, err := client.Tags.ListVideo(context.Background(), "1", opt)
 	if err != nil {
 		t.Errorf("Tags.ListVideo returned unexpected error: %v", err)
 	}
@@ -0,0 +1,54 @@
+package vimeo
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// timestampLayouts are the formats of the timestamps sent by the API.
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05-0700",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// Timestamp represents a time sent by the API. It tolerates the non RFC 3339
+// formats the API emits, an empty string or null is the zero time.
+type Timestamp struct {
+	time.Time
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	var err error
+	for _, layout := range timestampLayouts {
+		var v time.Time
+		if v, err = time.Parse(layout, s); err == nil {
+			t.Time = v
+			return nil
+		}
+	}
+
+	return err
+}
+
+// Equal reports whether t and u represent the same time instant.
+func (t Timestamp) Equal(u Timestamp) bool {
+	return t.Time.Equal(u.Time)
+}
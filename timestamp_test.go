@@ -0,0 +1,53 @@
+package vimeo
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTimestamp_UnmarshalJSON(t *testing.T) {
+	want := time.Date(2012, time.December, 20, 19, 51, 6, 0, time.UTC)
+
+	tests := []struct {
+		data string
+		want time.Time
+	}{
+		{`"2012-12-20T19:51:06+00:00"`, want},
+		{`"2012-12-20T19:51:06Z"`, want},
+		{`"2012-12-20T19:51:06+0000"`, want},
+		{`"2012-12-20 19:51:06"`, want},
+		{`""`, time.Time{}},
+		{`null`, time.Time{}},
+	}
+
+	for _, tt := range tests {
+		var ts Timestamp
+		if err := json.Unmarshal([]byte(tt.data), &ts); err != nil {
+			t.Errorf("Unmarshal(%s) returned unexpected error: %v", tt.data, err)
+			continue
+		}
+		if !ts.Time.Equal(tt.want) {
+			t.Errorf("Unmarshal(%s) returned %v, want %v", tt.data, ts, tt.want)
+		}
+	}
+}
+
+func TestTimestamp_UnmarshalJSON_invalid(t *testing.T) {
+	for _, data := range []string{`"yesterday"`, `1356033066`} {
+		var ts Timestamp
+		if err := json.Unmarshal([]byte(data), &ts); err == nil {
+			t.Errorf("Unmarshal(%s) expected error to be returned", data)
+		}
+	}
+}
+
+func TestTimestamp_inStruct(t *testing.T) {
+	var u User
+	if err := json.Unmarshal([]byte(`{"created_time": ""}`), &u); err != nil {
+		t.Fatalf("Unmarshal returned unexpected error: %v", err)
+	}
+	if !u.CreatedTime.IsZero() {
+		t.Errorf("CreatedTime is %v, want the zero time", u.CreatedTime)
+	}
+}
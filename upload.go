@@ -0,0 +1,369 @@
+package vimeo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+)
+
+// UploadService handles communication with the upload related
+// methods of the Vimeo API.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/upload/videos
+type UploadService service
+
+// PublishOptions specifies the optional parameters to the
+// UploadService.Publish method.
+type PublishOptions struct {
+	// User owning the video. The empty string means authenticated user.
+	User        string
+	Name        string
+	Description string
+	Privacy     *PrivacyRequest
+	// Album is the ID of the album the video is added to.
+	Album string
+	// PollInterval is the delay between two video status checks while
+	// waiting for the transcoding, 5 seconds by default.
+	PollInterval time.Duration
+}
+
+// transcodePollInterval is the default delay between two video status
+// checks while waiting for the transcoding.
+const transcodePollInterval = 5 * time.Second
+
+// Publish uploads a video with the tus approach, with its name, description
+// and privacy, adds it to the album and waits until the video is transcoded.
+// A file which isn't an io.ReaderAt is read sequentially, each chunk must
+// then be accepted in full. If the upload or any later step fails, the
+// created video is deleted.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/upload/videos
+func (s *UploadService) Publish(ctx context.Context, file io.Reader, size int64, opts *PublishOptions) (*Video, error) {
+	if opts == nil {
+		opts = &PublishOptions{}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	body := &tusVideoRequest{
+		Upload:      &tusUploadRequest{Approach: "tus", Size: size},
+		Name:        opts.Name,
+		Description: opts.Description,
+		Privacy:     opts.Privacy,
+	}
+
+	video, _, err := s.create(ctx, opts.User, body)
+	if err != nil {
+		return nil, err
+	}
+
+	video, err = s.publish(ctx, video, file, size, opts)
+	if err != nil {
+		// The rollback must not be skipped because ctx is done.
+		if _, derr := deleteVideo(context.Background(), s.client, video.URI); derr != nil {
+			return nil, fmt.Errorf("%w (rollback failed: %v)", err, derr)
+		}
+		return nil, err
+	}
+
+	return video, nil
+}
+
+func (s *UploadService) publish(ctx context.Context, video *Video, file io.Reader, size int64, opts *PublishOptions) (*Video, error) {
+	if _, err := sendChunks(ctx, s.client, video.Upload.UploadLink, file, size, 0, &UploadOptions{}); err != nil {
+		return video, err
+	}
+
+	if opts.Album != "" {
+		if err := ctx.Err(); err != nil {
+			return video, err
+		}
+
+		if _, err := s.client.Users.AlbumAddVideo(ctx, opts.User, opts.Album, video.GetID()); err != nil {
+			return video, err
+		}
+	}
+
+	return s.waitTranscode(ctx, video, opts.PollInterval)
+}
+
+// waitTranscode polls the status of the video every interval until it's
+// transcoded. The given video is returned on error.
+func (s *UploadService) waitTranscode(ctx context.Context, video *Video, interval time.Duration) (*Video, error) {
+	v, err := waitTranscode(ctx, s.client, video.URI, interval)
+	if err != nil {
+		return video, err
+	}
+
+	return v, nil
+}
+
+const (
+	tusVersion = "1.0.0"
+
+	defaultChunkSize = 128 << 20
+)
+
+// UploadOptions specifies the optional parameters to the
+// UploadService.Upload method.
+type UploadOptions struct {
+	// User owning the video. The empty string means authenticated user.
+	User        string
+	Name        string
+	Description string
+	Privacy     *PrivacyRequest
+	// EmbedPresetID is the ID of the embed preset assigned to the video
+	// once created.
+	EmbedPresetID int
+	// ChunkSize is the size of the uploaded chunks, 128 MB by default.
+	ChunkSize int64
+	// Progress, if not nil, is called after each uploaded chunk.
+	Progress func(uploaded, total int64)
+	// Wait makes UploadFromURL wait until the video is transcoded, checking
+	// its status every PollInterval, 5 seconds by default.
+	Wait         bool
+	PollInterval time.Duration
+}
+
+// tusUploadRequest is the upload block of a video creation, Size is set for
+// the "tus" approach and Link for the "pull" approach.
+type tusUploadRequest struct {
+	Approach string `json:"approach"`
+	Size     int64  `json:"size,omitempty"`
+	Link     string `json:"link,omitempty"`
+}
+
+type tusVideoRequest struct {
+	Upload      *tusUploadRequest `json:"upload"`
+	Name        string            `json:"name,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Privacy     *PrivacyRequest   `json:"privacy,omitempty"`
+}
+
+// Upload uploads the file with the resumable tus approach. If the upload
+// of the file fails, the returned video holds the upload link and can be
+// passed to Resume to continue from the last uploaded byte.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/upload/videos#resumable-approach
+func (s *UploadService) Upload(ctx context.Context, file *os.File, opt *UploadOptions) (*Video, *Response, error) {
+	if opt == nil {
+		opt = &UploadOptions{}
+	}
+
+	stat, err := file.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if stat.IsDir() {
+		return nil, nil, errors.New("the video file can't be a directory")
+	}
+
+	body := &tusVideoRequest{
+		Upload:      &tusUploadRequest{Approach: "tus", Size: stat.Size()},
+		Name:        opt.Name,
+		Description: opt.Description,
+		Privacy:     opt.Privacy,
+	}
+
+	video, resp, err := s.create(ctx, opt.User, body)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if resp, err := s.assignPreset(ctx, video, opt); err != nil {
+		return video, resp, err
+	}
+
+	return s.upload(ctx, video, file, stat.Size(), 0, opt)
+}
+
+// create creates the video of the user to upload with body. The video of the
+// "tus" approach must have an upload link.
+func (s *UploadService) create(ctx context.Context, user string, body *tusVideoRequest) (*Video, *Response, error) {
+	u, err := userPath(user, "videos")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("POST", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	video := &Video{}
+
+	resp, err := s.client.Do(ctx, req, video)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if body.Upload.Approach == "tus" && (video.Upload == nil || video.Upload.UploadLink == "") {
+		return nil, resp, errors.New("the upload ticket has no upload link")
+	}
+
+	return video, resp, nil
+}
+
+// UploadFromURL creates a video ingested by Vimeo from the source URL, with
+// the pull approach. The video is returned once created, or once transcoded
+// if opt.Wait is set.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/upload/videos#pull-approach
+func (s *UploadService) UploadFromURL(ctx context.Context, sourceURL string, opt *UploadOptions) (*Video, *Response, error) {
+	if opt == nil {
+		opt = &UploadOptions{}
+	}
+
+	body := &tusVideoRequest{
+		Upload:      &tusUploadRequest{Approach: "pull", Link: sourceURL},
+		Name:        opt.Name,
+		Description: opt.Description,
+		Privacy:     opt.Privacy,
+	}
+
+	video, resp, err := s.create(ctx, opt.User, body)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if resp, err := s.assignPreset(ctx, video, opt); err != nil {
+		return video, resp, err
+	}
+
+	if !opt.Wait {
+		return video, resp, nil
+	}
+
+	video, err = s.waitTranscode(ctx, video, opt.PollInterval)
+	return video, resp, err
+}
+
+// assignPreset assigns the embed preset of opt, if any, to the created video.
+func (s *UploadService) assignPreset(ctx context.Context, video *Video, opt *UploadOptions) (*Response, error) {
+	if opt.EmbedPresetID == 0 {
+		return nil, nil
+	}
+
+	resp, err := s.client.Videos.AssignPreset(ctx, video.GetID(), opt.EmbedPresetID)
+	if err != nil {
+		return resp, fmt.Errorf("failed to assign embed preset %d: %w", opt.EmbedPresetID, err)
+	}
+
+	return resp, nil
+}
+
+// Resume continues the upload of the video returned by a failed Upload.
+// The upload offset is requested from the upload link.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/upload/videos#resumable-approach
+func (s *UploadService) Resume(ctx context.Context, video *Video, file *os.File, opt *UploadOptions) (*Video, *Response, error) {
+	if opt == nil {
+		opt = &UploadOptions{}
+	}
+
+	if video == nil || video.Upload == nil || video.Upload.UploadLink == "" {
+		return nil, nil, errors.New("the video has no upload link")
+	}
+
+	stat, err := file.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := http.NewRequest("HEAD", video.Upload.UploadLink, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Tus-Resumable", tusVersion)
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		return video, resp, err
+	}
+
+	offset, err := strconv.ParseInt(resp.Header.Get("Upload-Offset"), 10, 64)
+	if err != nil {
+		return video, resp, fmt.Errorf("invalid upload offset: %v", err)
+	}
+
+	return s.upload(ctx, video, file, stat.Size(), offset, opt)
+}
+
+// upload sends the file from offset in chunks and returns the uploaded video.
+func (s *UploadService) upload(ctx context.Context, video *Video, file *os.File, size, offset int64, opt *UploadOptions) (*Video, *Response, error) {
+	resp, err := sendChunks(ctx, s.client, video.Upload.UploadLink, file, size, offset, opt)
+	if err != nil {
+		return video, resp, err
+	}
+
+	uploaded, resp, err := getVideo(ctx, s.client, video.URI)
+	if err != nil {
+		return video, resp, err
+	}
+
+	return uploaded, resp, nil
+}
+
+// sendChunks sends the file from offset to the tus upload link in chunks.
+// A file which isn't an io.ReaderAt is read sequentially from its current
+// position, each chunk must then be accepted in full.
+func sendChunks(ctx context.Context, c *Client, link string, file io.Reader, size, offset int64, opt *UploadOptions) (*Response, error) {
+	chunk := opt.ChunkSize
+	if chunk <= 0 {
+		chunk = defaultChunkSize
+	}
+
+	ra, seekable := file.(io.ReaderAt)
+
+	var resp *Response
+	for offset < size {
+		n := chunk
+		if size-offset < n {
+			n = size - offset
+		}
+
+		var body io.Reader
+		if seekable {
+			body = io.NewSectionReader(ra, offset, n)
+		} else {
+			body = io.LimitReader(file, n)
+		}
+
+		req, err := http.NewRequest("PATCH", link, body)
+		if err != nil {
+			return nil, err
+		}
+		req.ContentLength = n
+		req.Header.Set("Tus-Resumable", tusVersion)
+		req.Header.Set("Upload-Offset", strconv.FormatInt(offset, 10))
+		req.Header.Set("Content-Type", "application/offset+octet-stream")
+
+		resp, err = c.Do(ctx, req, nil)
+		if err != nil {
+			return resp, err
+		}
+
+		next, err := strconv.ParseInt(resp.Header.Get("Upload-Offset"), 10, 64)
+		if err != nil || next <= offset {
+			return resp, errors.New("the upload did not progress")
+		}
+		if !seekable && next != offset+n {
+			return resp, errors.New("the chunk was not uploaded in full")
+		}
+		offset = next
+
+		if opt.Progress != nil {
+			opt.Progress(offset, size)
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,342 @@
+package vimeo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func setupPublish(t *testing.T, content string, want *tusVideoRequest) {
+	mux.HandleFunc("/me/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		v := &tusVideoRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+		if !reflect.DeepEqual(v, want) {
+			t.Errorf("UploadService.Publish body is %+v, want %+v", v, want)
+		}
+		fmt.Fprintf(w, `{"uri": "/videos/1", "upload": {"approach": "tus", "upload_link": "%s/upload"}}`, server.URL)
+	})
+
+	mux.HandleFunc("/upload", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testHeader(t, r, "Upload-Offset", "0")
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != content {
+			t.Errorf("UploadService.Publish uploaded %q, want %q", body, content)
+		}
+		w.Header().Set("Upload-Offset", fmt.Sprint(len(body)))
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func TestUploadService_Publish(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := "video"
+	setupPublish(t, content, &tusVideoRequest{
+		Upload:  &tusUploadRequest{Approach: "tus", Size: int64(len(content))},
+		Name:    "n",
+		Privacy: &PrivacyRequest{View: "unlisted"},
+	})
+
+	checks := 0
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		checks++
+		if checks < 3 {
+			fmt.Fprint(w, `{"uri": "/videos/1", "status": "transcoding"}`)
+			return
+		}
+		fmt.Fprint(w, `{"uri": "/videos/1", "name": "n", "status": "available"}`)
+	})
+
+	added := false
+	mux.HandleFunc("/me/albums/a/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		added = true
+	})
+
+	opts := &PublishOptions{
+		Name:         "n",
+		Privacy:      &PrivacyRequest{View: "unlisted"},
+		Album:        "a",
+		PollInterval: time.Millisecond,
+	}
+	// A reader which isn't an io.ReaderAt is uploaded sequentially.
+	file := struct{ io.Reader }{strings.NewReader(content)}
+	video, err := client.Upload.Publish(context.Background(), file, int64(len(content)), opts)
+	if err != nil {
+		t.Fatalf("UploadService.Publish returned unexpected error: %v", err)
+	}
+
+	if !added {
+		t.Error("UploadService.Publish did not add the video to the album")
+	}
+
+	want := &Video{URI: "/videos/1", Name: "n", Status: "available"}
+	if !reflect.DeepEqual(video, want) {
+		t.Errorf("UploadService.Publish returned %+v, want %+v", video, want)
+	}
+}
+
+func TestUploadService_Publish_rollback(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := "video"
+	setupPublish(t, content, &tusVideoRequest{
+		Upload: &tusUploadRequest{Approach: "tus", Size: int64(len(content))},
+	})
+
+	deleted := false
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"uri": "/videos/1", "status": "transcoding"}`)
+		case "DELETE":
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request method %v", r.Method)
+		}
+	})
+
+	mux.HandleFunc("/me/albums/a/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.Upload.Publish(context.Background(), strings.NewReader(content), int64(len(content)), &PublishOptions{Album: "a"})
+	if err == nil {
+		t.Fatal("UploadService.Publish expected error")
+	}
+
+	if !deleted {
+		t.Error("UploadService.Publish did not delete the video")
+	}
+}
+
+func setupTus(t *testing.T, failAt int64) *bytes.Buffer {
+	uploaded := new(bytes.Buffer)
+
+	mux.HandleFunc("/me/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		v := &tusVideoRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+		want := &tusVideoRequest{Upload: &tusUploadRequest{Approach: "tus", Size: 10}, Name: "n"}
+		if !reflect.DeepEqual(v, want) {
+			t.Errorf("Upload.Upload body is %+v, want %+v", v, want)
+		}
+		fmt.Fprintf(w, `{"uri": "/videos/1", "upload": {"approach": "tus", "upload_link": "%s/tus"}}`, server.URL)
+	})
+
+	mux.HandleFunc("/tus", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Tus-Resumable", "1.0.0")
+		switch r.Method {
+		case "HEAD":
+		case "PATCH":
+			testHeader(t, r, "Content-Type", "application/offset+octet-stream")
+			if got, want := r.Header.Get("Upload-Offset"), fmt.Sprint(uploaded.Len()); got != want {
+				t.Errorf("Upload-Offset is %s, want %s", got, want)
+			}
+			if int64(uploaded.Len()) == failAt {
+				failAt = -1
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			io.Copy(uploaded, r.Body)
+		default:
+			t.Errorf("Unexpected request method %v", r.Method)
+		}
+		w.Header().Set("Upload-Offset", fmt.Sprint(uploaded.Len()))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"uri": "/videos/1", "upload": {"status": "complete"}}`)
+	})
+
+	return uploaded
+}
+
+func tempVideoFile(t *testing.T, content string) *os.File {
+	f, err := ioutil.TempFile("", "go-vimeo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.WriteString(content)
+	return f
+}
+
+func TestUploadService_Upload(t *testing.T) {
+	setup()
+	defer teardown()
+
+	uploaded := setupTus(t, -1)
+
+	f := tempVideoFile(t, "0123456789")
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	var progress []int64
+	opt := &UploadOptions{
+		Name:      "n",
+		ChunkSize: 4,
+		Progress:  func(n, total int64) { progress = append(progress, n) },
+	}
+
+	video, _, err := client.Upload.Upload(context.Background(), f, opt)
+	if err != nil {
+		t.Fatalf("Upload.Upload returned unexpected error: %v", err)
+	}
+
+	if got := uploaded.String(); got != "0123456789" {
+		t.Errorf("Upload.Upload uploaded %q, want %q", got, "0123456789")
+	}
+
+	if want := []int64{4, 8, 10}; !reflect.DeepEqual(progress, want) {
+		t.Errorf("Upload.Upload progress is %v, want %v", progress, want)
+	}
+
+	want := &Video{URI: "/videos/1", Upload: &VideoUpload{Status: "complete"}}
+	if !reflect.DeepEqual(video, want) {
+		t.Errorf("Upload.Upload returned %+v, want %+v", video, want)
+	}
+}
+
+func TestUploadService_Resume(t *testing.T) {
+	setup()
+	defer teardown()
+
+	uploaded := setupTus(t, 4)
+
+	f := tempVideoFile(t, "0123456789")
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	opt := &UploadOptions{Name: "n", ChunkSize: 4}
+
+	video, _, err := client.Upload.Upload(context.Background(), f, opt)
+	if err == nil {
+		t.Fatal("Upload.Upload expected error")
+	}
+
+	video, _, err = client.Upload.Resume(context.Background(), video, f, opt)
+	if err != nil {
+		t.Fatalf("Upload.Resume returned unexpected error: %v", err)
+	}
+
+	if got := uploaded.String(); got != "0123456789" {
+		t.Errorf("Upload.Resume uploaded %q, want %q", got, "0123456789")
+	}
+
+	if video.URI != "/videos/1" {
+		t.Errorf("Upload.Resume returned %+v", video)
+	}
+}
+
+func TestUploadService_UploadFromURL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		v := &tusVideoRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+		want := &tusVideoRequest{Upload: &tusUploadRequest{Approach: "pull", Link: "https://example.com/v.mp4"}, Name: "n"}
+		if !reflect.DeepEqual(v, want) {
+			t.Errorf("Upload.UploadFromURL body is %+v, want %+v", v, want)
+		}
+		fmt.Fprint(w, `{"uri": "/videos/1", "status": "transcode_starting"}`)
+	})
+
+	opt := &UploadOptions{User: "1", Name: "n"}
+	video, _, err := client.Upload.UploadFromURL(context.Background(), "https://example.com/v.mp4", opt)
+	if err != nil {
+		t.Fatalf("Upload.UploadFromURL returned unexpected error: %v", err)
+	}
+
+	want := &Video{URI: "/videos/1", Status: "transcode_starting"}
+	if !reflect.DeepEqual(video, want) {
+		t.Errorf("Upload.UploadFromURL returned %+v, want %+v", video, want)
+	}
+}
+
+func TestUploadService_UploadFromURL_embedPreset(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		v := &tusVideoRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+		want := &tusVideoRequest{
+			Upload:      &tusUploadRequest{Approach: "pull", Link: "https://example.com/v.mp4"},
+			Name:        "n",
+			Description: "d",
+			Privacy:     &PrivacyRequest{View: "nobody"},
+		}
+		if !reflect.DeepEqual(v, want) {
+			t.Errorf("Upload.UploadFromURL body is %+v, want %+v", v, want)
+		}
+		fmt.Fprint(w, `{"uri": "/videos/1"}`)
+	})
+
+	assigned := false
+	mux.HandleFunc("/videos/1/presets/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		assigned = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	opt := &UploadOptions{Name: "n", Description: "d", Privacy: &PrivacyRequest{View: "nobody"}, EmbedPresetID: 2}
+	if _, _, err := client.Upload.UploadFromURL(context.Background(), "https://example.com/v.mp4", opt); err != nil {
+		t.Fatalf("Upload.UploadFromURL returned unexpected error: %v", err)
+	}
+
+	if !assigned {
+		t.Error("Upload.UploadFromURL didn't assign the embed preset")
+	}
+}
+
+func TestUploadService_UploadFromURL_wait(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"uri": "/videos/1", "status": "transcode_starting"}`)
+	})
+
+	polls := 0
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		polls++
+		if polls < 2 {
+			fmt.Fprint(w, `{"uri": "/videos/1", "status": "transcoding"}`)
+			return
+		}
+		fmt.Fprint(w, `{"uri": "/videos/1", "status": "available"}`)
+	})
+
+	opt := &UploadOptions{Wait: true, PollInterval: time.Millisecond}
+	video, _, err := client.Upload.UploadFromURL(context.Background(), "https://example.com/v.mp4", opt)
+	if err != nil {
+		t.Fatalf("Upload.UploadFromURL returned unexpected error: %v", err)
+	}
+
+	want := &Video{URI: "/videos/1", Status: "available"}
+	if !reflect.DeepEqual(video, want) || polls != 2 {
+		t.Errorf("Upload.UploadFromURL returned %+v after %d polls, want %+v after 2", video, polls, want)
+	}
+}
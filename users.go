@@ -1,8 +1,17 @@
 package vimeo
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
 	"os"
+	"regexp"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -19,43 +28,192 @@ type dataListUser struct {
 
 // WebSite represents a web site.
 type WebSite struct {
+	URI         string `json:"uri,omitempty"`
 	Name        string `json:"name,omitempty"`
 	Link        string `json:"link,omitempty"`
 	Description string `json:"description,omitempty"`
+	Type        string `json:"type,omitempty"`
+}
+
+// SocialLinks represents the social accounts linked to the user profile.
+type SocialLinks struct {
+	Facebook  string
+	Twitter   string
+	Instagram string
+	YouTube   string
+	LinkedIn  string
+	// Other holds the web sites that are not a known social account.
+	Other []*WebSite
+}
+
+var socialHosts = map[string]string{
+	"facebook.com":  "facebook",
+	"twitter.com":   "twitter",
+	"x.com":         "twitter",
+	"instagram.com": "instagram",
+	"youtube.com":   "youtube",
+	"linkedin.com":  "linkedin",
 }
 
 // User represents a user.
 type User struct {
-	URI           string     `json:"uri,omitempty"`
-	Name          string     `json:"name,omitempty"`
-	Link          string     `json:"link,omitempty"`
-	Location      string     `json:"location,omitempty"`
-	Bio           string     `json:"bio,omitempty"`
-	CreatedTime   time.Time  `json:"created_time,omitempty"`
-	Account       string     `json:"account,omitempty"`
-	Pictures      *Pictures  `json:"pictures,omitempty"`
-	WebSites      []*WebSite `json:"websites,omitempty"`
-	ContentFilter []string   `json:"content_filter,omitempty"`
-	ResourceKey   string     `json:"resource_key,omitempty"`
+	URI           string       `json:"uri,omitempty"`
+	Name          string       `json:"name,omitempty"`
+	Link          string       `json:"link,omitempty"`
+	Location      string       `json:"location,omitempty"`
+	Bio           string       `json:"bio,omitempty"`
+	CreatedTime   Timestamp    `json:"created_time,omitempty"`
+	Account       string       `json:"account,omitempty"`
+	Pictures      *Pictures    `json:"pictures,omitempty"`
+	WebSites      []*WebSite   `json:"websites,omitempty"`
+	ContentFilter []string     `json:"content_filter,omitempty"`
+	ResourceKey   string       `json:"resource_key,omitempty"`
+	UploadQuota   *UploadQuota `json:"upload_quota,omitempty"`
+	Metadata      *Metadata    `json:"metadata,omitempty"`
+}
+
+// UploadQuota represents the upload quota of a user. It's only returned
+// for the users whose quota is visible to the authenticated user.
+type UploadQuota struct {
+	Space    *QuotaSpace    `json:"space,omitempty"`
+	Periodic *QuotaPeriodic `json:"periodic,omitempty"`
+}
+
+// QuotaSpace represents the storage space of a user, in bytes.
+type QuotaSpace struct {
+	Free int64 `json:"free,omitempty"`
+	Max  int64 `json:"max,omitempty"`
+	Used int64 `json:"used,omitempty"`
+}
+
+// QuotaPeriodic represents the upload quota of a user for the current
+// period, in bytes. Reset is when the quota is renewed.
+type QuotaPeriodic struct {
+	Free  int64     `json:"free,omitempty"`
+	Max   int64     `json:"max,omitempty"`
+	Used  int64     `json:"used,omitempty"`
+	Reset Timestamp `json:"reset_date,omitempty"`
 }
 
 // ListUserOptions specifies the optional parameters to the
 // ListUser method.
 type ListUserOptions struct {
-	Query  string `url:"query,omitempty"`
-	Filter string `url:"filter,omitempty"`
+	Query     string `url:"query,omitempty"`
+	Filter    string `url:"filter,omitempty"`
+	Sort      string `url:"sort,omitempty"`
+	Direction string `url:"direction,omitempty"`
+
+	// PictureSizes keeps only the pictures of the users with these widths,
+	// such as 100 for the 100x75 avatar. The API has no parameter to select
+	// the sizes, they're filtered once received. If Fields is set, the
+	// "pictures.sizes" field is requested as well.
+	PictureSizes []int `url:"-"`
 	ListOptions
 }
 
+// withPictureFields returns the options to send, with the "pictures.sizes"
+// field if the pictures are filtered from a restricted set of fields.
+func (o *ListUserOptions) withPictureFields() *ListUserOptions {
+	if o == nil || len(o.PictureSizes) == 0 || len(o.Fields) == 0 {
+		return o
+	}
+
+	for _, f := range o.Fields {
+		if f == "pictures" || f == "pictures.sizes" {
+			return o
+		}
+	}
+
+	opt := *o
+	opt.Fields = append(o.Fields[:len(o.Fields):len(o.Fields)], "pictures.sizes")
+	return &opt
+}
+
+// filterPictureSizes keeps only the picture sizes of the users with the
+// given widths, all of them if widths is empty.
+func filterPictureSizes(users []*User, widths []int) {
+	if len(widths) == 0 {
+		return
+	}
+
+	for _, user := range users {
+		if user.Pictures == nil {
+			continue
+		}
+
+		sizes := user.Pictures.Sizes[:0]
+		for _, size := range user.Pictures.Sizes {
+			for _, w := range widths {
+				if size.Width == w {
+					sizes = append(sizes, size)
+					break
+				}
+			}
+		}
+		user.Pictures.Sizes = sizes
+	}
+}
+
+func (o *ListUserOptions) validate() error {
+	if o == nil || o.Direction == "" || o.Direction == "asc" || o.Direction == "desc" {
+		return nil
+	}
+
+	return fmt.Errorf("invalid direction %q, must be \"asc\" or \"desc\"", o.Direction)
+}
+
 // UserRequest represents a request to create/edit an user.
 type UserRequest struct {
-	Name     string `json:"name,omitempty"`
-	Location string `json:"location,omitempty"`
-	Bio      string `json:"bio,omitempty"`
+	Name     string     `json:"name,omitempty"`
+	Location string     `json:"location,omitempty"`
+	Bio      string     `json:"bio,omitempty"`
+	WebSites []*WebSite `json:"websites,omitempty"`
+}
+
+// webSitesRequest replaces the web sites of an user, an empty list
+// removes all of them.
+type webSitesRequest struct {
+	WebSites []*WebSite `json:"websites"`
+}
+
+// SocialLinks returns the social accounts found in the user web sites.
+// The web site type is used when present, otherwise the link host.
+func (u User) SocialLinks() *SocialLinks {
+	links := &SocialLinks{}
+
+	for _, w := range u.WebSites {
+		kind := strings.ToLower(w.Type)
+		if kind == "" || kind == "link" {
+			if l, err := url.Parse(w.Link); err == nil {
+				kind = socialHosts[strings.TrimPrefix(strings.ToLower(l.Host), "www.")]
+			}
+		}
+
+		switch kind {
+		case "facebook":
+			links.Facebook = w.Link
+		case "twitter":
+			links.Twitter = w.Link
+		case "instagram":
+			links.Instagram = w.Link
+		case "youtube":
+			links.YouTube = w.Link
+		case "linkedin":
+			links.LinkedIn = w.Link
+		default:
+			links.Other = append(links.Other, w)
+		}
+	}
+
+	return links
 }
 
-func listUser(c *Client, url string, opt *ListUserOptions) ([]*User, *Response, error) {
-	u, err := addOptions(url, opt)
+func listUser(ctx context.Context, c *Client, url string, opt *ListUserOptions) ([]*User, *Response, error) {
+	if err := opt.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	u, err := addOptions(url, opt.withPictureFields())
 	if err != nil {
 		return nil, nil, err
 	}
@@ -67,35 +225,120 @@ func listUser(c *Client, url string, opt *ListUserOptions) ([]*User, *Response,
 
 	users := &dataListUser{}
 
-	resp, err := c.Do(req, users)
+	resp, err := c.Do(ctx, req, users)
 	if err != nil {
 		return nil, resp, err
 	}
 
 	resp.setPaging(users)
 
+	if opt != nil {
+		filterPictureSizes(users.Data, opt.PictureSizes)
+	}
+
 	return users.Data, resp, err
 }
 
+// UserIterator iterates over the users of a paginated list, fetching the
+// following pages on demand.
+type UserIterator struct {
+	ctx  context.Context
+	c    *Client
+	url  string
+	opt  *ListUserOptions
+	page []*User
+	user *User
+	done bool
+	err  error
+
+	sizes    []int // ListUserOptions.PictureSizes, kept across the pages
+	maxPages int   // ListOptions.MaxPages
+	pages    int
+}
+
+func newUserIterator(ctx context.Context, c *Client, url string, opt *ListUserOptions) *UserIterator {
+	o := ListUserOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	o.PerPage = c.perPage(o.PerPage)
+
+	return &UserIterator{ctx: ctx, c: c, url: url, opt: &o, sizes: o.PictureSizes, maxPages: o.MaxPages}
+}
+
+// Next advances the iterator to the next user. It returns false when the
+// last page is exhausted or an error occurred, see Err.
+func (it *UserIterator) Next() bool {
+	for len(it.page) == 0 {
+		if it.done || it.err != nil {
+			it.user = nil
+			return false
+		}
+		it.fetch()
+	}
+
+	it.user, it.page = it.page[0], it.page[1:]
+	return true
+}
+
+// User returns the current user.
+func (it *UserIterator) User() *User {
+	return it.user
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *UserIterator) Err() error {
+	return it.err
+}
+
+func (it *UserIterator) fetch() {
+	if it.maxPages > 0 && it.pages >= it.maxPages {
+		it.err = ErrMaxPagesReached
+		return
+	}
+
+	users, resp, err := listUser(it.ctx, it.c, it.url, it.opt)
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	filterPictureSizes(users, it.sizes)
+	it.page = users
+	it.pages++
+
+	// The next page reference already holds the query parameters.
+	it.url, it.opt = pagePath(resp.NextPage), nil
+	if it.url == "" {
+		it.done = true
+	}
+}
+
 // Search users.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/channels/%7Bchannel_id%7D/users
-func (s *UsersService) Search(opt *ListUserOptions) ([]*User, *Response, error) {
-	users, resp, err := listUser(s.client, "users", opt)
+func (s *UsersService) Search(ctx context.Context, opt *ListUserOptions) ([]*User, *Response, error) {
+	users, resp, err := listUser(ctx, s.client, "users", opt)
 
 	return users, resp, err
 }
 
+// SearchAll returns an iterator over all users matching the search, across
+// all pages.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users
+func (s *UsersService) SearchAll(ctx context.Context, opt *ListUserOptions) *UserIterator {
+	return newUserIterator(ctx, s.client, "users", opt)
+}
+
 // Get show one user.
 // Passing the empty string will authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D
-func (s *UsersService) Get(uid string) (*User, *Response, error) {
-	var u string
-	if uid == "" {
-		u = "me"
-	} else {
-		u = fmt.Sprintf("users/%s", uid)
+func (s *UsersService) Get(ctx context.Context, uid string) (*User, *Response, error) {
+	u, err := userPath(uid, "")
+	if err != nil {
+		return nil, nil, err
 	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -105,7 +348,22 @@ func (s *UsersService) Get(uid string) (*User, *Response, error) {
 
 	user := &User{}
 
-	resp, err := s.client.Do(req, user)
+	resp, err := s.client.Do(ctx, req, user)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return user, resp, err
+}
+
+// GetByURI returns the user at the URI returned by the API, such as
+// Feed.User.URI.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D
+func (s *UsersService) GetByURI(ctx context.Context, uri string) (*User, *Response, error) {
+	user := &User{}
+
+	resp, err := s.client.GetByURI(ctx, uri, user)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -113,16 +371,111 @@ func (s *UsersService) Get(uid string) (*User, *Response, error) {
 	return user, resp, err
 }
 
+// GetBatch gets many users in parallel, at most Client.Concurrency at a time.
+// The users are keyed by the given ID, the first error encountered is
+// returned.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D
+func (s *UsersService) GetBatch(ctx context.Context, ids []string) (map[string]*User, error) {
+	users := make([]*User, len(ids))
+	errs := make([]error, len(ids))
+	sem := make(chan struct{}, s.client.concurrency())
+
+	var wg sync.WaitGroup
+	for i, uid := range ids {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, uid string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			users[i], _, errs[i] = s.Get(ctx, uid)
+		}(i, uid)
+	}
+	wg.Wait()
+
+	result := make(map[string]*User, len(ids))
+	for i, uid := range ids {
+		if errs[i] != nil {
+			return nil, fmt.Errorf("failed to get user %q: %w", uid, errs[i])
+		}
+		result[uid] = users[i]
+	}
+
+	return result, nil
+}
+
+var (
+	usernameRe = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+	digitsRe   = regexp.MustCompile(`^[0-9]+$`)
+)
+
+// reservedPaths are the first path segments of vimeo.com which aren't
+// profiles, such as https://vimeo.com/channels/staffpicks.
+var reservedPaths = map[string]bool{
+	"channels": true,
+	"groups":   true,
+	"album":    true,
+	"showcase": true,
+	"ondemand": true,
+}
+
+// usernameFromURL extracts the vanity username from a profile URL
+// (https://vimeo.com/username), "@username" or a bare username. The URLs of
+// videos (https://vimeo.com/76979871) and of other pages are rejected.
+func usernameFromURL(rawURL string) (string, error) {
+	name := strings.TrimPrefix(strings.TrimSpace(rawURL), "@")
+
+	if strings.ContainsAny(name, "./") {
+		if !strings.Contains(name, "://") {
+			name = "https://" + name
+		}
+
+		u, err := url.Parse(name)
+		if err != nil {
+			return "", err
+		}
+
+		host := strings.TrimPrefix(strings.ToLower(u.Host), "www.")
+		if host != "vimeo.com" {
+			return "", fmt.Errorf("%q is not a Vimeo profile URL", rawURL)
+		}
+
+		name = strings.Trim(u.Path, "/")
+	}
+
+	if !usernameRe.MatchString(name) || digitsRe.MatchString(name) || reservedPaths[strings.ToLower(name)] {
+		return "", fmt.Errorf("can't extract a username from %q", rawURL)
+	}
+
+	return name, nil
+}
+
+// GetByURL show one user by the profile URL.
+// Accepts a full profile URL (https://vimeo.com/username), "@username"
+// or a bare username.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D
+func (s *UsersService) GetByURL(ctx context.Context, rawURL string) (*User, *Response, error) {
+	uid, err := usernameFromURL(rawURL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user, resp, err := s.Get(ctx, uid)
+
+	return user, resp, err
+}
+
 // Edit one user.
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D
-func (s *UsersService) Edit(uid string, r *UserRequest) (*User, *Response, error) {
-	var u string
-	if uid == "" {
-		u = "me"
-	} else {
-		u = fmt.Sprintf("users/%s", uid)
+func (s *UsersService) Edit(ctx context.Context, uid string, r *UserRequest) (*User, *Response, error) {
+	u, err := userPath(uid, "")
+	if err != nil {
+		return nil, nil, err
 	}
 
 	req, err := s.client.NewRequest("PATCH", u, r)
@@ -131,7 +484,7 @@ func (s *UsersService) Edit(uid string, r *UserRequest) (*User, *Response, error
 	}
 
 	user := &User{}
-	resp, err := s.client.Do(req, user)
+	resp, err := s.client.Do(ctx, req, user)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -139,19 +492,85 @@ func (s *UsersService) Edit(uid string, r *UserRequest) (*User, *Response, error
 	return user, resp, nil
 }
 
-// ListAppearance all videos a user is credited in.
+func (s *UsersService) editWebSites(ctx context.Context, uid string, edit func([]*WebSite) ([]*WebSite, error)) (*User, *Response, error) {
+	user, resp, err := s.Get(ctx, uid)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	sites, err := edit(user.WebSites)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u, err := userPath(uid, "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("PATCH", u, &webSitesRequest{WebSites: sites})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user = &User{}
+	resp, err = s.client.Do(ctx, req, user)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return user, resp, nil
+}
+
+// AddWebSite adds a web site to the user profile.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D
+func (s *UsersService) AddWebSite(ctx context.Context, uid string, w *WebSite) (*User, *Response, error) {
+	return s.editWebSites(ctx, uid, func(sites []*WebSite) ([]*WebSite, error) {
+		return append(sites, w), nil
+	})
+}
+
+// EditWebSite replaces the web site at index i in the user profile.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D
+func (s *UsersService) EditWebSite(ctx context.Context, uid string, i int, w *WebSite) (*User, *Response, error) {
+	return s.editWebSites(ctx, uid, func(sites []*WebSite) ([]*WebSite, error) {
+		if i < 0 || i >= len(sites) {
+			return nil, fmt.Errorf("web site index %d out of range", i)
+		}
+		sites[i] = w
+		return sites, nil
+	})
+}
+
+// RemoveWebSite removes the web site at index i from the user profile.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D
+func (s *UsersService) RemoveWebSite(ctx context.Context, uid string, i int) (*User, *Response, error) {
+	return s.editWebSites(ctx, uid, func(sites []*WebSite) ([]*WebSite, error) {
+		if i < 0 || i >= len(sites) {
+			return nil, fmt.Errorf("web site index %d out of range", i)
+		}
+		return append(sites[:i:i], sites[i+1:]...), nil
+	})
+}
+
+// ListAppearance all videos a user is credited in. The videos uploaded by
+// the user are listed by ListVideo.
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/appearances
-func (s *UsersService) ListAppearance(uid string, opt *ListVideoOptions) ([]*Video, *Response, error) {
-	var u string
-	if uid == "" {
-		u = "me/appearances"
-	} else {
-		u = fmt.Sprintf("users/%s/appearances", uid)
+func (s *UsersService) ListAppearance(ctx context.Context, uid string, opt *ListVideoOptions) ([]*Video, *Response, error) {
+	u, err := userPath(uid, "appearances")
+	if err != nil {
+		return nil, nil, err
 	}
 
-	videos, resp, err := listVideo(s.client, u, opt)
+	videos, resp, err := listVideo(ctx, s.client, u, opt)
 
 	return videos, resp, err
 }
@@ -160,29 +579,26 @@ func (s *UsersService) ListAppearance(uid string, opt *ListVideoOptions) ([]*Vid
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/categories
-func (s *UsersService) ListCategory(uid string, opt *ListCategoryOptions) ([]*Category, *Response, error) {
-	var u string
-	if uid == "" {
-		u = "me/categories"
-	} else {
-		u = fmt.Sprintf("users/%s/categories", uid)
+func (s *UsersService) ListCategory(ctx context.Context, uid string, opt *ListCategoryOptions) ([]*Category, *Response, error) {
+	u, err := userPath(uid, "categories")
+	if err != nil {
+		return nil, nil, err
 	}
 
-	categories, resp, err := listCategory(s.client, u, opt)
+	categories, resp, err := listCategory(ctx, s.client, u, opt)
 
 	return categories, resp, err
 }
 
-// SubscribeCategory subscribe category user.
+// SubscribeCategory subscribe category user. The categories can be
+// browsed with CategoriesService.List beforehand.
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/categories/%7Bcategory%7D
-func (s *UsersService) SubscribeCategory(uid string, cat string) (*Response, error) {
-	var u string
-	if uid == "" {
-		u = fmt.Sprintf("me/categories/%s", cat)
-	} else {
-		u = fmt.Sprintf("users/%s/categories/%s", uid, cat)
+func (s *UsersService) SubscribeCategory(ctx context.Context, uid string, cat string) (*Response, error) {
+	u, err := userPath(uid, "categories/%s", cat)
+	if err != nil {
+		return nil, err
 	}
 
 	req, err := s.client.NewRequest("PUT", u, nil)
@@ -190,19 +606,17 @@ func (s *UsersService) SubscribeCategory(uid string, cat string) (*Response, err
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
 
 // UnsubscribeCategory unsubscribe category current user.
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/categories/%7Bcategory%7D
-func (s *UsersService) UnsubscribeCategory(uid string, cat string) (*Response, error) {
-	var u string
-	if uid == "" {
-		u = fmt.Sprintf("me/categories/%s", cat)
-	} else {
-		u = fmt.Sprintf("users/%s/categories/%s", uid, cat)
+func (s *UsersService) UnsubscribeCategory(ctx context.Context, uid string, cat string) (*Response, error) {
+	u, err := userPath(uid, "categories/%s", cat)
+	if err != nil {
+		return nil, err
 	}
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
@@ -210,22 +624,20 @@ func (s *UsersService) UnsubscribeCategory(uid string, cat string) (*Response, e
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
 
 // ListChannel list the subscribed channel for user.
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/channels
-func (s *UsersService) ListChannel(uid string, opt *ListChannelOptions) ([]*Channel, *Response, error) {
-	var u string
-	if uid == "" {
-		u = "me/channels"
-	} else {
-		u = fmt.Sprintf("users/%s/channels", uid)
+func (s *UsersService) ListChannel(ctx context.Context, uid string, opt *ListChannelOptions) ([]*Channel, *Response, error) {
+	u, err := userPath(uid, "channels")
+	if err != nil {
+		return nil, nil, err
 	}
 
-	categories, resp, err := listChannel(s.client, u, opt)
+	categories, resp, err := listChannel(ctx, s.client, u, opt)
 
 	return categories, resp, err
 }
@@ -234,12 +646,10 @@ func (s *UsersService) ListChannel(uid string, opt *ListChannelOptions) ([]*Chan
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/channels/%7Bchannel_id%7D
-func (s *UsersService) SubscribeChannel(uid string, ch string) (*Response, error) {
-	var u string
-	if uid == "" {
-		u = fmt.Sprintf("me/channels/%s", ch)
-	} else {
-		u = fmt.Sprintf("users/%s/channels/%s", uid, ch)
+func (s *UsersService) SubscribeChannel(ctx context.Context, uid string, ch string) (*Response, error) {
+	u, err := userPath(uid, "channels/%s", ch)
+	if err != nil {
+		return nil, err
 	}
 
 	req, err := s.client.NewRequest("PUT", u, nil)
@@ -247,19 +657,17 @@ func (s *UsersService) SubscribeChannel(uid string, ch string) (*Response, error
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
 
 // UnsubscribeChannel unsubscribe channel user.
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/channels/%7Bchannel_id%7D
-func (s *UsersService) UnsubscribeChannel(uid string, ch string) (*Response, error) {
-	var u string
-	if uid == "" {
-		u = fmt.Sprintf("me/channels/%s", ch)
-	} else {
-		u = fmt.Sprintf("users/%s/channels/%s", uid, ch)
+func (s *UsersService) UnsubscribeChannel(ctx context.Context, uid string, ch string) (*Response, error) {
+	u, err := userPath(uid, "channels/%s", ch)
+	if err != nil {
+		return nil, err
 	}
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
@@ -267,7 +675,7 @@ func (s *UsersService) UnsubscribeChannel(uid string, ch string) (*Response, err
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
 
 type dataListFeed struct {
@@ -277,13 +685,20 @@ type dataListFeed struct {
 
 // Feed represents a feed.
 type Feed struct {
-	URI  string `json:"uri,omitempty"`
+	URI string `json:"uri,omitempty"`
+	// Type is the action behind the item, such as "share", "like" or
+	// "appearance", and User is the user who did it.
+	Type string `json:"type,omitempty"`
+	User *User  `json:"user,omitempty"`
 	Clip *Video `json:"clip,omitempty"`
 }
 
 // ListFeedOptions specifies the optional parameters to the
 // Feed method.
 type ListFeedOptions struct {
+	// Type is one of "video", "share", "like" or "appearance".
+	Type   string `url:"type,omitempty"`
+	Filter string `url:"filter,omitempty"`
 	ListOptions
 }
 
@@ -291,15 +706,13 @@ type ListFeedOptions struct {
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/feed
-func (s *UsersService) Feed(uid string, opt *ListFeedOptions) ([]*Feed, *Response, error) {
-	var u string
-	if uid == "" {
-		u = "me/feed"
-	} else {
-		u = fmt.Sprintf("users/%s/feed", uid)
+func (s *UsersService) Feed(ctx context.Context, uid string, opt *ListFeedOptions) ([]*Feed, *Response, error) {
+	u, err := userPath(uid, "feed")
+	if err != nil {
+		return nil, nil, err
 	}
 
-	u, err := addOptions(u, opt)
+	u, err = addOptions(u, opt)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -311,7 +724,7 @@ func (s *UsersService) Feed(uid string, opt *ListFeedOptions) ([]*Feed, *Respons
 
 	feed := &dataListFeed{}
 
-	resp, err := s.client.Do(req, feed)
+	resp, err := s.client.Do(ctx, req, feed)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -325,46 +738,96 @@ func (s *UsersService) Feed(uid string, opt *ListFeedOptions) ([]*Feed, *Respons
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/followers
-func (s *UsersService) ListFollower(uid string, opt *ListUserOptions) ([]*User, *Response, error) {
-	var u string
-	if uid == "" {
-		u = "me/followers"
-	} else {
-		u = fmt.Sprintf("users/%s/followers", uid)
+func (s *UsersService) ListFollower(ctx context.Context, uid string, opt *ListUserOptions) ([]*User, *Response, error) {
+	u, err := userPath(uid, "followers")
+	if err != nil {
+		return nil, nil, err
 	}
 
-	users, resp, err := listUser(s.client, u, opt)
+	users, resp, err := listUser(ctx, s.client, u, opt)
 
 	return users, resp, err
 }
 
+// ListFollowerAll returns an iterator over all followers, across all pages.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/followers
+func (s *UsersService) ListFollowerAll(ctx context.Context, uid string, opt *ListUserOptions) *UserIterator {
+	u, err := userPath(uid, "followers")
+	if err != nil {
+		return &UserIterator{err: err}
+	}
+
+	return newUserIterator(ctx, s.client, u, opt)
+}
+
+// CountFollowers returns the number of followers.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/followers
+func (s *UsersService) CountFollowers(ctx context.Context, uid string) (int, *Response, error) {
+	u, err := userPath(uid, "followers")
+	if err != nil {
+		return 0, nil, err
+	}
+
+	total, resp, err := countList(ctx, s.client, u)
+
+	return total, resp, err
+}
+
 // ListFollowed lists the following.
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/following
-func (s *UsersService) ListFollowed(uid string, opt *ListUserOptions) ([]*User, *Response, error) {
-	var u string
-	if uid == "" {
-		u = "me/following"
-	} else {
-		u = fmt.Sprintf("users/%s/following", uid)
+func (s *UsersService) ListFollowed(ctx context.Context, uid string, opt *ListUserOptions) ([]*User, *Response, error) {
+	u, err := userPath(uid, "following")
+	if err != nil {
+		return nil, nil, err
 	}
 
-	users, resp, err := listUser(s.client, u, opt)
+	users, resp, err := listUser(ctx, s.client, u, opt)
 
 	return users, resp, err
 }
 
+// ListFollowedAll returns an iterator over all followed users, across all pages.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/following
+func (s *UsersService) ListFollowedAll(ctx context.Context, uid string, opt *ListUserOptions) *UserIterator {
+	u, err := userPath(uid, "following")
+	if err != nil {
+		return &UserIterator{err: err}
+	}
+
+	return newUserIterator(ctx, s.client, u, opt)
+}
+
+// CountFollowing returns the number of followed users.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/following
+func (s *UsersService) CountFollowing(ctx context.Context, uid string) (int, *Response, error) {
+	u, err := userPath(uid, "following")
+	if err != nil {
+		return 0, nil, err
+	}
+
+	total, resp, err := countList(ctx, s.client, u)
+
+	return total, resp, err
+}
+
 // FollowUser follow a user.
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/following/%7Bfollow_user_id%7D
-func (s *UsersService) FollowUser(uid string, fid string) (*Response, error) {
-	var u string
-	if uid == "" {
-		u = fmt.Sprintf("me/following/%s", fid)
-	} else {
-		u = fmt.Sprintf("users/%s/following/%s", uid, fid)
+func (s *UsersService) FollowUser(ctx context.Context, uid string, fid string) (*Response, error) {
+	u, err := userPath(uid, "following/%s", fid)
+	if err != nil {
+		return nil, err
 	}
 
 	req, err := s.client.NewRequest("PUT", u, nil)
@@ -372,19 +835,17 @@ func (s *UsersService) FollowUser(uid string, fid string) (*Response, error) {
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
 
 // UnfollowUser unfollow a user.
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/following/%7Bfollow_user_id%7D
-func (s *UsersService) UnfollowUser(uid string, fid string) (*Response, error) {
-	var u string
-	if uid == "" {
-		u = fmt.Sprintf("me/following/%s", fid)
-	} else {
-		u = fmt.Sprintf("users/%s/following/%s", uid, fid)
+func (s *UsersService) UnfollowUser(ctx context.Context, uid string, fid string) (*Response, error) {
+	u, err := userPath(uid, "following/%s", fid)
+	if err != nil {
+		return nil, err
 	}
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
@@ -392,22 +853,20 @@ func (s *UsersService) UnfollowUser(uid string, fid string) (*Response, error) {
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
 
 // ListGroup lists all joined groups.
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/groups
-func (s *UsersService) ListGroup(uid string, opt *ListGroupOptions) ([]*Group, *Response, error) {
-	var u string
-	if uid == "" {
-		u = "me/groups"
-	} else {
-		u = fmt.Sprintf("users/%s/groups", uid)
+func (s *UsersService) ListGroup(ctx context.Context, uid string, opt *ListGroupOptions) ([]*Group, *Response, error) {
+	u, err := userPath(uid, "groups")
+	if err != nil {
+		return nil, nil, err
 	}
 
-	groups, resp, err := listGroup(s.client, u, opt)
+	groups, resp, err := listGroup(ctx, s.client, u, opt)
 
 	return groups, resp, err
 }
@@ -416,12 +875,10 @@ func (s *UsersService) ListGroup(uid string, opt *ListGroupOptions) ([]*Group, *
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/groups/%7Bgroup_id%7D
-func (s *UsersService) JoinGroup(uid string, gid string) (*Response, error) {
-	var u string
-	if uid == "" {
-		u = fmt.Sprintf("me/groups/%s", gid)
-	} else {
-		u = fmt.Sprintf("users/%s/groups/%s", uid, gid)
+func (s *UsersService) JoinGroup(ctx context.Context, uid string, gid string) (*Response, error) {
+	u, err := userPath(uid, "groups/%s", gid)
+	if err != nil {
+		return nil, err
 	}
 
 	req, err := s.client.NewRequest("PUT", u, nil)
@@ -429,19 +886,17 @@ func (s *UsersService) JoinGroup(uid string, gid string) (*Response, error) {
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
 
 // LeaveGroup leaved user from group.
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/groups/%7Bgroup_id%7D
-func (s *UsersService) LeaveGroup(uid string, gid string) (*Response, error) {
-	var u string
-	if uid == "" {
-		u = fmt.Sprintf("me/groups/%s", gid)
-	} else {
-		u = fmt.Sprintf("users/%s/groups/%s", uid, gid)
+func (s *UsersService) LeaveGroup(ctx context.Context, uid string, gid string) (*Response, error) {
+	u, err := userPath(uid, "groups/%s", gid)
+	if err != nil {
+		return nil, err
 	}
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
@@ -449,36 +904,50 @@ func (s *UsersService) LeaveGroup(uid string, gid string) (*Response, error) {
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
 
 // ListLikedVideo all liked videos.
 // Passing the empty string will edit authenticated user.
+// To list only the videos that can be embedded, set Filter to "embeddable"
+// and FilterEmbeddable to Bool(true) in opt.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/likes
-func (s *UsersService) ListLikedVideo(uid string, opt *ListVideoOptions) ([]*Video, *Response, error) {
-	var u string
-	if uid == "" {
-		u = "me/likes"
-	} else {
-		u = fmt.Sprintf("users/%s/likes", uid)
+func (s *UsersService) ListLikedVideo(ctx context.Context, uid string, opt *ListVideoOptions) ([]*Video, *Response, error) {
+	u, err := userPath(uid, "likes")
+	if err != nil {
+		return nil, nil, err
 	}
 
-	videos, resp, err := listVideo(s.client, u, opt)
+	videos, resp, err := listVideo(ctx, s.client, u, opt)
 
 	return videos, resp, err
 }
 
-// LikeVideo like one video.
+// CountLikes returns the number of liked videos.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/likes
+func (s *UsersService) CountLikes(ctx context.Context, uid string) (int, *Response, error) {
+	u, err := userPath(uid, "likes")
+	if err != nil {
+		return 0, nil, err
+	}
+
+	total, resp, err := countList(ctx, s.client, u)
+
+	return total, resp, err
+}
+
+// LikeVideo like one video. Liking a video twice is reported as an error
+// wrapping the *ErrorResponse.
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/likes/%7Bvideo_id%7D
-func (s *UsersService) LikeVideo(uid string, vid int) (*Response, error) {
-	var u string
-	if uid == "" {
-		u = fmt.Sprintf("me/likes/%d", vid)
-	} else {
-		u = fmt.Sprintf("users/%s/likes/%d", uid, vid)
+func (s *UsersService) LikeVideo(ctx context.Context, uid string, vid int) (*Response, error) {
+	u, err := userPath(uid, "likes/%d", vid)
+	if err != nil {
+		return nil, err
 	}
 
 	req, err := s.client.NewRequest("PUT", u, nil)
@@ -486,19 +955,22 @@ func (s *UsersService) LikeVideo(uid string, vid int) (*Response, error) {
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil && resp != nil && resp.StatusCode == http.StatusConflict {
+		err = fmt.Errorf("video %d is already liked: %w", vid, err)
+	}
+
+	return resp, err
 }
 
 // UnlikeVideo unlike one video.
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/likes/%7Bvideo_id%7D
-func (s *UsersService) UnlikeVideo(uid string, vid int) (*Response, error) {
-	var u string
-	if uid == "" {
-		u = fmt.Sprintf("me/likes/%d", vid)
-	} else {
-		u = fmt.Sprintf("users/%s/likes/%d", uid, vid)
+func (s *UsersService) UnlikeVideo(ctx context.Context, uid string, vid int) (*Response, error) {
+	u, err := userPath(uid, "likes/%d", vid)
+	if err != nil {
+		return nil, err
 	}
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
@@ -506,19 +978,17 @@ func (s *UsersService) UnlikeVideo(uid string, vid int) (*Response, error) {
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
 
 // RemovePortrait removed specific a portrait.
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/pictures/%7Bportraitset_id%7D
-func (s *UsersService) RemovePortrait(uid string, pid string) (*Response, error) {
-	var u string
-	if uid == "" {
-		u = fmt.Sprintf("me/pictures/%s", pid)
-	} else {
-		u = fmt.Sprintf("users/%s/pictures/%s", uid, pid)
+func (s *UsersService) RemovePortrait(ctx context.Context, uid string, pid string) (*Response, error) {
+	u, err := userPath(uid, "pictures/%s", pid)
+	if err != nil {
+		return nil, err
 	}
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
@@ -526,73 +996,151 @@ func (s *UsersService) RemovePortrait(uid string, pid string) (*Response, error)
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
 
-// ListVideo lists the video for user.
+// maxAvatarSize is the size limit of the images uploaded by UploadAvatar.
+const maxAvatarSize = 10 << 20
+
+// UploadAvatar uploads the JPEG or PNG image as a new portrait of user and
+// makes it active. An image larger than 10 MB is rejected before the upload.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/pictures
+func (s *UsersService) UploadAvatar(ctx context.Context, uid string, img io.Reader) (*Pictures, *Response, error) {
+	u, err := userPath(uid, "pictures")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(img, maxAvatarSize+1))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(data) > maxAvatarSize {
+		return nil, nil, fmt.Errorf("the image exceeds the size limit of %d bytes", maxAvatarSize)
+	}
+
+	switch ct := http.DetectContentType(data); ct {
+	case "image/jpeg", "image/png":
+	default:
+		return nil, nil, fmt.Errorf("unsupported image type %q, must be JPEG or PNG", ct)
+	}
+
+	return uploadPictures(ctx, s.client, u, bytes.NewReader(data))
+}
+
+// ListVideo lists the videos uploaded by user.
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/videos
-func (s *UsersService) ListVideo(uid string, opt *ListVideoOptions) ([]*Video, *Response, error) {
-	var u string
-	if uid == "" {
-		u = "me/videos"
-	} else {
-		u = fmt.Sprintf("users/%s/videos", uid)
+func (s *UsersService) ListVideo(ctx context.Context, uid string, opt *ListVideoOptions) ([]*Video, *Response, error) {
+	u, err := userPath(uid, "videos")
+	if err != nil {
+		return nil, nil, err
 	}
 
-	videos, resp, err := listVideo(s.client, u, opt)
+	videos, resp, err := listVideo(ctx, s.client, u, opt)
 
 	return videos, resp, err
 }
 
+// CountVideos returns the number of videos.
+// Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/videos
+func (s *UsersService) CountVideos(ctx context.Context, uid string) (int, *Response, error) {
+	u, err := userPath(uid, "videos")
+	if err != nil {
+		return 0, nil, err
+	}
+
+	total, resp, err := countList(ctx, s.client, u)
+
+	return total, resp, err
+}
+
+// WalkSince calls fn for each video of the user created at or after since,
+// newest first. Vimeo has no date range filter for video lists, so videos
+// are requested sorted by date and paging stops at the first video created
+// before since. Passing the empty string will edit authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/videos
+func (s *UsersService) WalkSince(ctx context.Context, uid string, since time.Time, fn func(*Video) error) error {
+	opt := &ListVideoOptions{
+		Sort:        "date",
+		Direction:   "desc",
+		ListOptions: ListOptions{Page: 1, PerPage: 100},
+	}
+
+	for {
+		videos, resp, err := s.ListVideo(ctx, uid, opt)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range videos {
+			if v.CreatedTime.Before(since) {
+				return nil
+			}
+
+			if err := fn(v); err != nil {
+				return err
+			}
+		}
+
+		if resp.NextPage == "" {
+			return nil
+		}
+
+		opt.Page++
+	}
+}
+
 // GetVideo get specific video by video ID.
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/videos
-func (s *UsersService) GetVideo(uid string, vid int) (*Video, *Response, error) {
-	var u string
-	if uid == "" {
-		u = fmt.Sprintf("me/videos/%d", vid)
-	} else {
-		u = fmt.Sprintf("users/%s/videos/%d", uid, vid)
+func (s *UsersService) GetVideo(ctx context.Context, uid string, vid int) (*Video, *Response, error) {
+	u, err := userPath(uid, "videos/%d", vid)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	video, resp, err := getVideo(s.client, u)
+	video, resp, err := getVideo(ctx, s.client, u)
 
 	return video, resp, err
 }
 
-// UploadVideo upload video file.
+// UploadVideo upload video file. It uses the legacy streaming upload,
+// requested with version 3.2 of the API whatever Client.APIVersion.
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/videos
-func (s *UsersService) UploadVideo(uid string, file *os.File) (*Video, *Response, error) {
-	var u string
-	if uid == "" {
-		u = "me/videos"
-	} else {
-		u = fmt.Sprintf("users/%s/videos", uid)
+func (s *UsersService) UploadVideo(ctx context.Context, uid string, file *os.File) (*Video, *Response, error) {
+	u, err := userPath(uid, "videos")
+	if err != nil {
+		return nil, nil, err
 	}
 
-	video, resp, err := uploadVideo(s.client, u, file)
+	video, resp, err := uploadVideo(ctx, s.client, u, file)
 
 	return video, resp, err
 }
 
-// UploadVideo upload video by url.
+// UploadVideo upload video by url. It uses the legacy pull upload,
+// requested with version 3.2 of the API whatever Client.APIVersion.
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/videos
-func (s *UsersService) UploadVideoByURL(uid string, videoURL string) (*Video, *Response, error) {
-	var u string
-	if uid == "" {
-		u = "me/videos"
-	} else {
-		u = fmt.Sprintf("users/%s/videos", uid)
+func (s *UsersService) UploadVideoByURL(ctx context.Context, uid string, videoURL string) (*Video, *Response, error) {
+	u, err := userPath(uid, "videos")
+	if err != nil {
+		return nil, nil, err
 	}
 
-	video, resp, err := uploadVideoByURL(s.client, u, videoURL)
+	video, resp, err := uploadVideoByURL(ctx, s.client, u, videoURL)
 
 	return video, resp, err
 }
@@ -601,15 +1149,13 @@ func (s *UsersService) UploadVideoByURL(uid string, videoURL string) (*Video, *R
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/watchlater
-func (s *UsersService) WatchLaterListVideo(uid string, opt *ListVideoOptions) ([]*Video, *Response, error) {
-	var u string
-	if uid == "" {
-		u = "me/watchlater"
-	} else {
-		u = fmt.Sprintf("users/%s/watchlater", uid)
+func (s *UsersService) WatchLaterListVideo(ctx context.Context, uid string, opt *ListVideoOptions) ([]*Video, *Response, error) {
+	u, err := userPath(uid, "watchlater")
+	if err != nil {
+		return nil, nil, err
 	}
 
-	videos, resp, err := listVideo(s.client, u, opt)
+	videos, resp, err := listVideo(ctx, s.client, u, opt)
 
 	return videos, resp, err
 }
@@ -618,15 +1164,13 @@ func (s *UsersService) WatchLaterListVideo(uid string, opt *ListVideoOptions) ([
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/watchlater/%7Bvideo_id%7D
-func (s *UsersService) WatchLaterGetVideo(uid string, vid int) (*Video, *Response, error) {
-	var u string
-	if uid == "" {
-		u = fmt.Sprintf("me/watchlater/%d", vid)
-	} else {
-		u = fmt.Sprintf("users/%s/watchlater/%d", uid, vid)
+func (s *UsersService) WatchLaterGetVideo(ctx context.Context, uid string, vid int) (*Video, *Response, error) {
+	u, err := userPath(uid, "watchlater/%d", vid)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	video, resp, err := getVideo(s.client, u)
+	video, resp, err := getVideo(ctx, s.client, u)
 
 	return video, resp, err
 }
@@ -635,12 +1179,10 @@ func (s *UsersService) WatchLaterGetVideo(uid string, vid int) (*Video, *Respons
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/watchlater/%7Bvideo_id%7D
-func (s *UsersService) WatchLaterAddVideo(uid string, vid int) (*Response, error) {
-	var u string
-	if uid == "" {
-		u = fmt.Sprintf("me/watchlater/%d", vid)
-	} else {
-		u = fmt.Sprintf("users/%s/watchlater/%d", uid, vid)
+func (s *UsersService) WatchLaterAddVideo(ctx context.Context, uid string, vid int) (*Response, error) {
+	u, err := userPath(uid, "watchlater/%d", vid)
+	if err != nil {
+		return nil, err
 	}
 
 	req, err := s.client.NewRequest("PUT", u, nil)
@@ -648,19 +1190,17 @@ func (s *UsersService) WatchLaterAddVideo(uid string, vid int) (*Response, error
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
 
 // WatchLaterDeleteVideo delete video from watch later list.
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/watchlater/%7Bvideo_id%7D
-func (s *UsersService) WatchLaterDeleteVideo(uid string, vid int) (*Response, error) {
-	var u string
-	if uid == "" {
-		u = fmt.Sprintf("me/watchlater/%d", vid)
-	} else {
-		u = fmt.Sprintf("users/%s/watchlater/%d", uid, vid)
+func (s *UsersService) WatchLaterDeleteVideo(ctx context.Context, uid string, vid int) (*Response, error) {
+	u, err := userPath(uid, "watchlater/%d", vid)
+	if err != nil {
+		return nil, err
 	}
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
@@ -668,14 +1208,14 @@ func (s *UsersService) WatchLaterDeleteVideo(uid string, vid int) (*Response, er
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
 
 // WatchedListVideo lists the video.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/me/watched/videos
-func (s *UsersService) WatchedListVideo(uid string, opt *ListVideoOptions) ([]*Video, *Response, error) {
-	videos, resp, err := listVideo(s.client, "me/watched/videos", opt)
+func (s *UsersService) WatchedListVideo(ctx context.Context, uid string, opt *ListVideoOptions) ([]*Video, *Response, error) {
+	videos, resp, err := listVideo(ctx, s.client, "me/watched/videos", opt)
 
 	return videos, resp, err
 }
@@ -683,24 +1223,24 @@ func (s *UsersService) WatchedListVideo(uid string, opt *ListVideoOptions) ([]*V
 // ClearWatchedList delete all video from watch history.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/me/watchlater/%7Bvideo_id%7D
-func (s *UsersService) ClearWatchedList(uid string) (*Response, error) {
+func (s *UsersService) ClearWatchedList(ctx context.Context, uid string) (*Response, error) {
 	req, err := s.client.NewRequest("DELETE", "me/watched/videos", nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
 
 // WatchedDeleteVideo delete specific video from watch history.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/me/watched/videos/%7Bvideo_id%7D
-func (s *UsersService) WatchedDeleteVideo(uid string, vid int) (*Response, error) {
+func (s *UsersService) WatchedDeleteVideo(ctx context.Context, uid string, vid int) (*Response, error) {
 	u := fmt.Sprintf("me/watched/videos/%d", vid)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
@@ -1,8 +1,11 @@
 package vimeo
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"time"
+	"net/http"
+	"regexp"
 )
 
 type dataListAlbum struct {
@@ -16,6 +19,7 @@ type Privacy struct {
 	Join     string `json:"join,omitempty"`
 	Videos   string `json:"videos,omitempty"`
 	Comment  string `json:"comment,omitempty"`
+	Comments string `json:"comments,omitempty"`
 	Forums   string `json:"forums,omitempty"`
 	Invite   string `json:"invite,omitempty"`
 	Embed    string `json:"embed,omitempty"`
@@ -30,11 +34,22 @@ type Album struct {
 	Description  string    `json:"description,omitempty"`
 	Link         string    `json:"link,omitempty"`
 	Duration     int       `json:"duration,omitempty"`
-	CreatedTime  time.Time `json:"created_time,omitempty"`
-	ModifiedTime time.Time `json:"modified_time,omitempty"`
+	CreatedTime  Timestamp `json:"created_time,omitempty"`
+	ModifiedTime Timestamp `json:"modified_time,omitempty"`
 	User         *User     `json:"user,omitempty"`
 	Pictures     *Pictures `json:"pictures,omitempty"`
 	Privacy      *Privacy  `json:"privacy,omitempty"`
+	Theme        string    `json:"theme,omitempty"`
+	Layout       string    `json:"layout,omitempty"`
+	BrandColor   string    `json:"brand_color,omitempty"`
+	CustomURL    string    `json:"url,omitempty"`
+	Domain       string    `json:"domain,omitempty"`
+	Metadata     *Metadata `json:"metadata,omitempty"`
+}
+
+// VideoCount returns the number of videos in the album.
+func (a Album) VideoCount() int {
+	return a.Metadata.total("videos")
 }
 
 // ListAlbumOptions specifies the optional parameters to the
@@ -53,21 +68,73 @@ type AlbumRequest struct {
 	Privacy     string `json:"privacy,omitempty"`
 	Password    string `json:"password,omitempty"`
 	Sort        string `json:"sort,omitempty"`
+	Theme       string `json:"theme,omitempty"`
+	Layout      string `json:"layout,omitempty"`
+	BrandColor  string `json:"brand_color,omitempty"`
+	CustomURL   string `json:"url,omitempty"`
+	Domain      string `json:"domain,omitempty"`
+}
+
+var (
+	albumThemes  = map[string]bool{"standard": true, "dark": true}
+	albumLayouts = map[string]bool{"grid": true, "player": true}
+	albumSorts   = map[string]bool{
+		"added_first": true, "added_last": true, "alphabetical": true, "arranged": true,
+		"comments": true, "likes": true, "newest": true, "oldest": true, "plays": true,
+	}
+	brandColorRe = regexp.MustCompile(`^#?[0-9a-fA-F]{6}$`)
+	albumSlugRe  = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+)
+
+func (r *AlbumRequest) validate() error {
+	if r == nil {
+		return nil
+	}
+
+	if r.Theme != "" && !albumThemes[r.Theme] {
+		return fmt.Errorf("invalid album theme %q", r.Theme)
+	}
+
+	if r.Layout != "" && !albumLayouts[r.Layout] {
+		return fmt.Errorf("invalid album layout %q", r.Layout)
+	}
+
+	if r.Sort != "" && !albumSorts[r.Sort] {
+		return fmt.Errorf("invalid album sort %q", r.Sort)
+	}
+
+	if r.BrandColor != "" && !brandColorRe.MatchString(r.BrandColor) {
+		return errors.New("the album brand color must be a hex color code")
+	}
+
+	if r.CustomURL != "" && !albumSlugRe.MatchString(r.CustomURL) {
+		return fmt.Errorf("invalid album custom url %q: use lowercase letters, digits and hyphens", r.CustomURL)
+	}
+
+	return nil
+}
+
+// conflict reports a clear error when the API rejected the custom url
+// because another album already uses it.
+func (r *AlbumRequest) conflict(resp *Response, err error) error {
+	if r != nil && r.CustomURL != "" && resp != nil && resp.StatusCode == http.StatusConflict {
+		return fmt.Errorf("album custom url %q is already taken: %w", r.CustomURL, err)
+	}
+
+	return err
 }
 
 // ListAlbum lists the album for an current user.
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/albums
-func (s *UsersService) ListAlbum(uid string, opt *ListAlbumOptions) ([]*Album, *Response, error) {
-	var u string
-	if uid == "" {
-		u = "me/albums"
-	} else {
-		u = fmt.Sprintf("users/%s/albums", uid)
+func (s *UsersService) ListAlbum(ctx context.Context, uid string, opt *ListAlbumOptions) ([]*Album, *Response, error) {
+	u, err := userPath(uid, "albums")
+	if err != nil {
+		return nil, nil, err
 	}
 
-	u, err := addOptions(u, opt)
+	u, err = addOptions(u, opt)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -79,7 +146,7 @@ func (s *UsersService) ListAlbum(uid string, opt *ListAlbumOptions) ([]*Album, *
 
 	albums := &dataListAlbum{}
 
-	resp, err := s.client.Do(req, albums)
+	resp, err := s.client.Do(ctx, req, albums)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -93,12 +160,14 @@ func (s *UsersService) ListAlbum(uid string, opt *ListAlbumOptions) ([]*Album, *
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/albums
-func (s *UsersService) CreateAlbum(uid string, r *AlbumRequest) (*Album, *Response, error) {
-	var u string
-	if uid == "" {
-		u = "me/albums"
-	} else {
-		u = fmt.Sprintf("users/%s/albums", uid)
+func (s *UsersService) CreateAlbum(ctx context.Context, uid string, r *AlbumRequest) (*Album, *Response, error) {
+	u, err := userPath(uid, "albums")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := r.validate(); err != nil {
+		return nil, nil, err
 	}
 
 	req, err := s.client.NewRequest("POST", u, r)
@@ -107,9 +176,9 @@ func (s *UsersService) CreateAlbum(uid string, r *AlbumRequest) (*Album, *Respon
 	}
 
 	album := &Album{}
-	resp, err := s.client.Do(req, album)
+	resp, err := s.client.Do(ctx, req, album)
 	if err != nil {
-		return nil, resp, err
+		return nil, resp, r.conflict(resp, err)
 	}
 
 	return album, resp, nil
@@ -119,12 +188,10 @@ func (s *UsersService) CreateAlbum(uid string, r *AlbumRequest) (*Album, *Respon
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/albums/%7Balbum_id%7D
-func (s *UsersService) GetAlbum(uid string, ab string) (*Album, *Response, error) {
-	var u string
-	if uid == "" {
-		u = fmt.Sprintf("me/albums/%s", ab)
-	} else {
-		u = fmt.Sprintf("users/%s/albums/%s", uid, ab)
+func (s *UsersService) GetAlbum(ctx context.Context, uid string, ab string) (*Album, *Response, error) {
+	u, err := userPath(uid, "albums/%s", ab)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -134,7 +201,7 @@ func (s *UsersService) GetAlbum(uid string, ab string) (*Album, *Response, error
 
 	album := &Album{}
 
-	resp, err := s.client.Do(req, album)
+	resp, err := s.client.Do(ctx, req, album)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -146,12 +213,14 @@ func (s *UsersService) GetAlbum(uid string, ab string) (*Album, *Response, error
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/albums/%7Balbum_id%7D
-func (s *UsersService) EditAlbum(uid string, ab string, r *AlbumRequest) (*Album, *Response, error) {
-	var u string
-	if uid == "" {
-		u = fmt.Sprintf("me/albums/%s", ab)
-	} else {
-		u = fmt.Sprintf("users/%s/albums/%s", uid, ab)
+func (s *UsersService) EditAlbum(ctx context.Context, uid string, ab string, r *AlbumRequest) (*Album, *Response, error) {
+	u, err := userPath(uid, "albums/%s", ab)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := r.validate(); err != nil {
+		return nil, nil, err
 	}
 
 	req, err := s.client.NewRequest("PATCH", u, r)
@@ -160,9 +229,9 @@ func (s *UsersService) EditAlbum(uid string, ab string, r *AlbumRequest) (*Album
 	}
 
 	album := &Album{}
-	resp, err := s.client.Do(req, album)
+	resp, err := s.client.Do(ctx, req, album)
 	if err != nil {
-		return nil, resp, err
+		return nil, resp, r.conflict(resp, err)
 	}
 
 	return album, resp, nil
@@ -172,12 +241,10 @@ func (s *UsersService) EditAlbum(uid string, ab string, r *AlbumRequest) (*Album
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/albums/%7Balbum_id%7D
-func (s *UsersService) DeleteAlbum(uid string, ab string) (*Response, error) {
-	var u string
-	if uid == "" {
-		u = fmt.Sprintf("me/albums/%s", ab)
-	} else {
-		u = fmt.Sprintf("users/%s/albums/%s", uid, ab)
+func (s *UsersService) DeleteAlbum(ctx context.Context, uid string, ab string) (*Response, error) {
+	u, err := userPath(uid, "albums/%s", ab)
+	if err != nil {
+		return nil, err
 	}
 
 	req, err := s.client.NewRequest("DELETE", u, nil)
@@ -185,21 +252,19 @@ func (s *UsersService) DeleteAlbum(uid string, ab string) (*Response, error) {
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
 
 // AlbumListVideo lists the video for an album.
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/albums/%7Balbum_id%7D/videos
-func (s *UsersService) AlbumListVideo(uid string, ab string, opt *ListVideoOptions) ([]*Video, *Response, error) {
-	var u string
-	if uid == "" {
-		u = fmt.Sprintf("me/albums/%s/videos", ab)
-	} else {
-		u = fmt.Sprintf("users/%s/albums/%s/videos", uid, ab)
+func (s *UsersService) AlbumListVideo(ctx context.Context, uid string, ab string, opt *ListVideoOptions) ([]*Video, *Response, error) {
+	u, err := userPath(uid, "albums/%s/videos", ab)
+	if err != nil {
+		return nil, nil, err
 	}
-	videos, resp, err := listVideo(s.client, u, opt)
+	videos, resp, err := listVideo(ctx, s.client, u, opt)
 
 	return videos, resp, err
 }
@@ -208,14 +273,12 @@ func (s *UsersService) AlbumListVideo(uid string, ab string, opt *ListVideoOptio
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/albums/%7Balbum_id%7D/videos/%7Bvideo_id%7D
-func (s *UsersService) AlbumGetVideo(uid string, ab string, vid int) (*Video, *Response, error) {
-	var u string
-	if uid == "" {
-		u = fmt.Sprintf("me/albums/%s/videos/%d", ab, vid)
-	} else {
-		u = fmt.Sprintf("users/%s/albums/%s/videos/%d", uid, ab, vid)
+func (s *UsersService) AlbumGetVideo(ctx context.Context, uid string, ab string, vid int) (*Video, *Response, error) {
+	u, err := userPath(uid, "albums/%s/videos/%d", ab, vid)
+	if err != nil {
+		return nil, nil, err
 	}
-	video, resp, err := getVideo(s.client, u)
+	video, resp, err := getVideo(ctx, s.client, u)
 
 	return video, resp, err
 }
@@ -224,14 +287,12 @@ func (s *UsersService) AlbumGetVideo(uid string, ab string, vid int) (*Video, *R
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/albums/%7Balbum_id%7D/videos/%7Bvideo_id%7D
-func (s *UsersService) AlbumAddVideo(uid string, ab string, vid int) (*Response, error) {
-	var u string
-	if uid == "" {
-		u = fmt.Sprintf("me/albums/%s/videos/%d", ab, vid)
-	} else {
-		u = fmt.Sprintf("users/%s/albums/%s/videos/%d", uid, ab, vid)
+func (s *UsersService) AlbumAddVideo(ctx context.Context, uid string, ab string, vid int) (*Response, error) {
+	u, err := userPath(uid, "albums/%s/videos/%d", ab, vid)
+	if err != nil {
+		return nil, err
 	}
-	resp, err := addVideo(s.client, u)
+	resp, err := addVideo(ctx, s.client, u)
 
 	return resp, err
 }
@@ -240,15 +301,13 @@ func (s *UsersService) AlbumAddVideo(uid string, ab string, vid int) (*Response,
 // Passing the empty string will edit authenticated user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/albums/%7Balbum_id%7D/videos/%7Bvideo_id%7D
-func (s *UsersService) AlbumDeleteVideo(uid string, ab string, vid int) (*Response, error) {
-	var u string
-	if uid == "" {
-		u = fmt.Sprintf("me/albums/%s/videos/%d", ab, vid)
-	} else {
-		u = fmt.Sprintf("users/%s/albums/%s/videos/%d", uid, ab, vid)
+func (s *UsersService) AlbumDeleteVideo(ctx context.Context, uid string, ab string, vid int) (*Response, error) {
+	u, err := userPath(uid, "albums/%s/videos/%d", ab, vid)
+	if err != nil {
+		return nil, err
 	}
 
-	resp, err := deleteVideo(s.client, u)
+	resp, err := deleteVideo(ctx, s.client, u)
 
 	return resp, err
 }
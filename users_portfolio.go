@@ -1,9 +1,6 @@
 package vimeo
 
-import (
-	"fmt"
-	"time"
-)
+import "context"
 
 type dataListPortfolio struct {
 	Data []*Portfolio `json:"data,omitempty"`
@@ -16,8 +13,8 @@ type Portfolio struct {
 	Name         string    `json:"name,omitempty"`
 	Description  string    `json:"description,omitempty"`
 	Link         string    `json:"link,omitempty"`
-	CreatedTime  time.Time `json:"created_time,omitempty"`
-	ModifiedTime time.Time `json:"modified_time,omitempty"`
+	CreatedTime  Timestamp `json:"created_time,omitempty"`
+	ModifiedTime Timestamp `json:"modified_time,omitempty"`
 	Sort         string    `json:"sort,omitempty"`
 }
 
@@ -33,15 +30,13 @@ type ListPortfolioOptions struct {
 // ListPortfolio lists the portfolio for user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/portfolios
-func (s *UsersService) ListPortfolio(uid string, opt *ListPortfolioOptions) ([]*Portfolio, *Response, error) {
-	var u string
-	if uid == "" {
-		u = "me/portfolios"
-	} else {
-		u = fmt.Sprintf("users/%s/portfolios", uid)
+func (s *UsersService) ListPortfolio(ctx context.Context, uid string, opt *ListPortfolioOptions) ([]*Portfolio, *Response, error) {
+	u, err := userPath(uid, "portfolios")
+	if err != nil {
+		return nil, nil, err
 	}
 
-	u, err := addOptions(u, opt)
+	u, err = addOptions(u, opt)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -53,7 +48,7 @@ func (s *UsersService) ListPortfolio(uid string, opt *ListPortfolioOptions) ([]*
 
 	portfolio := &dataListPortfolio{}
 
-	resp, err := s.client.Do(req, portfolio)
+	resp, err := s.client.Do(ctx, req, portfolio)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -66,12 +61,10 @@ func (s *UsersService) ListPortfolio(uid string, opt *ListPortfolioOptions) ([]*
 // GetProtfolio get portfolio by name.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/portfolios/%7Bportfolio_id%7D
-func (s *UsersService) GetProtfolio(uid string, p string) (*Portfolio, *Response, error) {
-	var u string
-	if uid == "" {
-		u = fmt.Sprintf("me/portfolios/%s", p)
-	} else {
-		u = fmt.Sprintf("users/%s/portfolios/%s", uid, p)
+func (s *UsersService) GetProtfolio(ctx context.Context, uid string, p string) (*Portfolio, *Response, error) {
+	u, err := userPath(uid, "portfolios/%s", p)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -81,7 +74,7 @@ func (s *UsersService) GetProtfolio(uid string, p string) (*Portfolio, *Response
 
 	portf := &Portfolio{}
 
-	resp, err := s.client.Do(req, portf)
+	resp, err := s.client.Do(ctx, req, portf)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -92,15 +85,13 @@ func (s *UsersService) GetProtfolio(uid string, p string) (*Portfolio, *Response
 // ProtfolioListVideo lists the video for an portfolio.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/portfolios/%7Bportfolio_id%7D/videos
-func (s *UsersService) ProtfolioListVideo(uid string, p string, opt *ListVideoOptions) ([]*Video, *Response, error) {
-	var u string
-	if uid == "" {
-		u = fmt.Sprintf("me/portfolios/%s/videos", p)
-	} else {
-		u = fmt.Sprintf("users/%s/portfolios/%s/videos", uid, p)
+func (s *UsersService) ProtfolioListVideo(ctx context.Context, uid string, p string, opt *ListVideoOptions) ([]*Video, *Response, error) {
+	u, err := userPath(uid, "portfolios/%s/videos", p)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	videos, resp, err := listVideo(s.client, u, opt)
+	videos, resp, err := listVideo(ctx, s.client, u, opt)
 
 	return videos, resp, err
 }
@@ -108,15 +99,13 @@ func (s *UsersService) ProtfolioListVideo(uid string, p string, opt *ListVideoOp
 // ProtfolioGetVideo get specific video by portfolio name and video ID.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/portfolios/%7Bportfolio_id%7D/videos/%7Bvideo_id%7D
-func (s *UsersService) ProtfolioGetVideo(uid string, p string, vid int) (*Video, *Response, error) {
-	var u string
-	if uid == "" {
-		u = fmt.Sprintf("me/portfolios/%s/videos/%d", p, vid)
-	} else {
-		u = fmt.Sprintf("users/%s/portfolios/%s/videos/%d", uid, p, vid)
+func (s *UsersService) ProtfolioGetVideo(ctx context.Context, uid string, p string, vid int) (*Video, *Response, error) {
+	u, err := userPath(uid, "portfolios/%s/videos/%d", p, vid)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	video, resp, err := getVideo(s.client, u)
+	video, resp, err := getVideo(ctx, s.client, u)
 
 	return video, resp, err
 }
@@ -124,12 +113,10 @@ func (s *UsersService) ProtfolioGetVideo(uid string, p string, vid int) (*Video,
 // ProtfolioAddVideo add one video.
 //
 // Vimeo API docs: hhttps://developer.vimeo.com/api/playground/users/%7Buser_id%7D/portfolios/%7Bportfolio_id%7D/videos/%7Bvideo_id%7D
-func (s *UsersService) ProtfolioAddVideo(uid string, p string, vid int) (*Response, error) {
-	var u string
-	if uid == "" {
-		u = fmt.Sprintf("me/portfolios/%s/videos/%d", p, vid)
-	} else {
-		u = fmt.Sprintf("users/%s/portfolios/%s/videos/%d", uid, p, vid)
+func (s *UsersService) ProtfolioAddVideo(ctx context.Context, uid string, p string, vid int) (*Response, error) {
+	u, err := userPath(uid, "portfolios/%s/videos/%d", p, vid)
+	if err != nil {
+		return nil, err
 	}
 
 	req, err := s.client.NewRequest("PUT", u, nil)
@@ -137,21 +124,19 @@ func (s *UsersService) ProtfolioAddVideo(uid string, p string, vid int) (*Respon
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
 
 // ProtfolioDeleteVideo delete specific video by portfolio name and video ID.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/portfolios/%7Bportfolio_id%7D/videos/%7Bvideo_id%7D
-func (s *UsersService) ProtfolioDeleteVideo(uid string, p string, vid int) (*Response, error) {
-	var u string
-	if uid == "" {
-		u = fmt.Sprintf("me/portfolios/%s/videos/%d", p, vid)
-	} else {
-		u = fmt.Sprintf("users/%s/portfolios/%s/videos/%d", uid, p, vid)
+func (s *UsersService) ProtfolioDeleteVideo(ctx context.Context, uid string, p string, vid int) (*Response, error) {
+	u, err := userPath(uid, "portfolios/%s/videos/%d", p, vid)
+	if err != nil {
+		return nil, err
 	}
 
-	resp, err := deleteVideo(s.client, u)
+	resp, err := deleteVideo(ctx, s.client, u)
 
 	return resp, err
 }
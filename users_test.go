@@ -1,11 +1,20 @@
 package vimeo
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
+	"net/url"
+	"os"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestUsersService_Search(t *testing.T) {
@@ -24,7 +33,7 @@ func TestUsersService_Search(t *testing.T) {
 	opt := &ListUserOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	users, _, err := client.Users.Search(opt)
+	users, _, err := client.Users.Search(context.Background(), opt)
 	if err != nil {
 		t.Errorf("Users.Search returned unexpected error: %v", err)
 	}
@@ -35,6 +44,169 @@ func TestUsersService_Search(t *testing.T) {
 	}
 }
 
+func TestUsersService_Search_sort(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"query":     "Test",
+			"sort":      "alphabetical",
+			"direction": "asc",
+		})
+		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
+	})
+
+	opt := &ListUserOptions{Query: "Test", Sort: "alphabetical", Direction: "asc"}
+	users, _, err := client.Users.Search(context.Background(), opt)
+	if err != nil {
+		t.Errorf("Users.Search returned unexpected error: %v", err)
+	}
+
+	want := []*User{{Name: "Test"}}
+	if !reflect.DeepEqual(users, want) {
+		t.Errorf("Users.Search returned %+v, want %+v", users, want)
+	}
+}
+
+func TestUsersService_Search_invalidDirection(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Users.Search sent a request with an invalid direction")
+	})
+
+	_, _, err := client.Users.Search(context.Background(), &ListUserOptions{Direction: "up"})
+	if err == nil {
+		t.Error("Users.Search expected error")
+	}
+}
+
+func TestUsersService_SearchAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			testFormValues(t, r, values{"query": "a"})
+			fmt.Fprint(w, `{"data": [{"name": "1"}, {"name": "2"}], "paging": {"next": "/users?query=a&page=2"}}`)
+		case "2":
+			testFormValues(t, r, values{"query": "a", "page": "2"})
+			fmt.Fprint(w, `{"data": [{"name": "3"}], "paging": {"next": null}}`)
+		}
+	})
+
+	it := client.Users.SearchAll(context.Background(), &ListUserOptions{Query: "a"})
+
+	var names []string
+	for it.Next() {
+		names = append(names, it.User().Name)
+	}
+
+	if err := it.Err(); err != nil {
+		t.Errorf("UserIterator returned unexpected error: %v", err)
+	}
+
+	want := []string{"1", "2", "3"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("UserIterator returned %v, want %v", names, want)
+	}
+}
+
+func TestUsersService_SearchAll_baseURLPath(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.BaseURL, _ = url.Parse(server.URL + "/api/")
+
+	mux.HandleFunc("/api/users", func(w http.ResponseWriter, r *http.Request) {
+		switch r.FormValue("page") {
+		case "":
+			fmt.Fprint(w, `{"data": [{"name": "1"}], "paging": {"next": "/users?query=a&page=2"}}`)
+		case "2":
+			fmt.Fprint(w, `{"data": [{"name": "2"}], "paging": {"next": null}}`)
+		}
+	})
+
+	it := client.Users.SearchAll(context.Background(), &ListUserOptions{Query: "a"})
+
+	var names []string
+	for it.Next() {
+		names = append(names, it.User().Name)
+	}
+
+	if err := it.Err(); err != nil {
+		t.Errorf("UserIterator returned unexpected error: %v", err)
+	}
+
+	if want := []string{"1", "2"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("UserIterator returned %v, want %v", names, want)
+	}
+}
+
+func TestUsersService_ListFollowerAll_error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/followers", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("page") == "2" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprint(w, `{"data": [{"name": "1"}], "paging": {"next": "/me/followers?page=2"}}`)
+	})
+
+	it := client.Users.ListFollowerAll(context.Background(), "", nil)
+
+	n := 0
+	for it.Next() {
+		n++
+	}
+
+	if n != 1 {
+		t.Errorf("UserIterator returned %d users, want 1", n)
+	}
+	if it.Err() == nil {
+		t.Error("UserIterator expected error")
+	}
+	if it.Next() {
+		t.Error("UserIterator.Next returned true after error")
+	}
+}
+
+func TestUsersService_ListFollowerAll_maxPages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.PerPage = 500
+
+	mux.HandleFunc("/me/followers", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.FormValue("per_page"); r.FormValue("page") == "" && got != "100" {
+			t.Errorf("Request per_page: %v, want 100", got)
+		}
+		fmt.Fprint(w, `{"data": [{"name": "1"}], "paging": {"next": "/me/followers?page=2&per_page=100"}}`)
+	})
+
+	opt := &ListUserOptions{ListOptions: ListOptions{MaxPages: 2}}
+	it := client.Users.ListFollowerAll(context.Background(), "", opt)
+
+	n := 0
+	for it.Next() {
+		n++
+	}
+
+	if n != 2 {
+		t.Errorf("UserIterator returned %d users, want 2", n)
+	}
+	if err := it.Err(); err != ErrMaxPagesReached {
+		t.Errorf("UserIterator returned error %v, want %v", err, ErrMaxPagesReached)
+	}
+}
+
 func TestUsersService_Get(t *testing.T) {
 	setup()
 	defer teardown()
@@ -44,7 +216,7 @@ func TestUsersService_Get(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	user, _, err := client.Users.Get("1")
+	user, _, err := client.Users.Get(context.Background(), "1")
 	if err != nil {
 		t.Errorf("Users.Get returned unexpected error: %v", err)
 	}
@@ -55,6 +227,74 @@ func TestUsersService_Get(t *testing.T) {
 	}
 }
 
+func TestUsersService_GetByURI(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name": "Test"}`)
+	})
+
+	user, _, err := client.Users.GetByURI(context.Background(), "/users/1")
+	if err != nil {
+		t.Errorf("Users.GetByURI returned unexpected error: %v", err)
+	}
+
+	want := &User{Name: "Test"}
+	if !reflect.DeepEqual(user, want) {
+		t.Errorf("Users.GetByURI returned %+v, want %+v", user, want)
+	}
+}
+
+func TestUsersService_Get_uploadQuota(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name": "Test", "upload_quota": {"space": {"free": 10, "max": 30, "used": 20}, "periodic": {"free": 5, "max": 15, "used": 10, "reset_date": "2020-01-01T00:00:00+00:00"}}}`)
+	})
+
+	user, _, err := client.Users.Get(context.Background(), "1")
+	if err != nil {
+		t.Errorf("Users.Get returned unexpected error: %v", err)
+	}
+
+	want := &UploadQuota{
+		Space:    &QuotaSpace{Free: 10, Max: 30, Used: 20},
+		Periodic: &QuotaPeriodic{Free: 5, Max: 15, Used: 10, Reset: Timestamp{time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)}},
+	}
+	if user.UploadQuota == nil || !reflect.DeepEqual(user.UploadQuota.Space, want.Space) {
+		t.Fatalf("Users.Get returned upload quota %+v, want %+v", user.UploadQuota, want)
+	}
+	if p := user.UploadQuota.Periodic; p == nil || p.Free != 5 || p.Max != 15 || !p.Reset.Equal(want.Periodic.Reset) {
+		t.Errorf("Users.Get returned periodic quota %+v, want %+v", p, want.Periodic)
+	}
+}
+
+func TestUsersService_Get_metadata(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name": "Test", "metadata": {"connections": {"followers": {"uri": "/users/1/followers", "total": 1234}}}}`)
+	})
+
+	user, _, err := client.Users.Get(context.Background(), "1")
+	if err != nil {
+		t.Errorf("Users.Get returned unexpected error: %v", err)
+	}
+
+	want := &Metadata{Connections: map[string]*Connection{
+		"followers": {URI: "/users/1/followers", Total: 1234},
+	}}
+	if !reflect.DeepEqual(user.Metadata, want) {
+		t.Errorf("Users.Get returned metadata %+v, want %+v", user.Metadata, want)
+	}
+}
+
 func TestUsersService_Get_authenticatedUser(t *testing.T) {
 	setup()
 	defer teardown()
@@ -64,7 +304,7 @@ func TestUsersService_Get_authenticatedUser(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	user, _, err := client.Users.Get("")
+	user, _, err := client.Users.Get(context.Background(), "")
 	if err != nil {
 		t.Errorf("Users.Get returned unexpected error: %v", err)
 	}
@@ -75,6 +315,121 @@ func TestUsersService_Get_authenticatedUser(t *testing.T) {
 	}
 }
 
+func TestUsersService_Get_invalidID(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request to %v", r.URL)
+	})
+
+	for _, uid := range []string{"../me", "1%2F..%2Fme"} {
+		if _, _, err := client.Users.Get(context.Background(), uid); err == nil {
+			t.Errorf("Users.Get(%q) expected error to be returned", uid)
+		}
+	}
+
+	it := client.Users.ListFollowerAll(context.Background(), "../1", nil)
+	if it.Next() || it.Err() == nil {
+		t.Errorf("Users.ListFollowerAll expected error to be returned")
+	}
+}
+
+func TestUsersService_GetBatch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.Concurrency = 2
+
+	mux.HandleFunc("/users/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"name": "%s"}`, strings.TrimPrefix(r.URL.Path, "/users/"))
+	})
+
+	users, err := client.Users.GetBatch(context.Background(), []string{"1", "2", "3"})
+	if err != nil {
+		t.Errorf("Users.GetBatch returned unexpected error: %v", err)
+	}
+
+	want := map[string]*User{"1": {Name: "1"}, "2": {Name: "2"}, "3": {Name: "3"}}
+	if !reflect.DeepEqual(users, want) {
+		t.Errorf("Users.GetBatch returned %+v, want %+v", users, want)
+	}
+}
+
+func TestUsersService_GetBatch_error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"name": "1"}`)
+	})
+	mux.HandleFunc("/users/2", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"error": "not found"}`, http.StatusNotFound)
+	})
+
+	_, err := client.Users.GetBatch(context.Background(), []string{"1", "2"})
+	if err == nil || !strings.Contains(err.Error(), `"2"`) {
+		t.Errorf("Users.GetBatch returned error %v, want an error for user 2", err)
+	}
+}
+
+func TestUsersService_GetByURL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/johndoe", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name": "Test"}`)
+	})
+
+	for _, input := range []string{
+		"https://vimeo.com/johndoe",
+		"http://www.vimeo.com/johndoe/",
+		"vimeo.com/johndoe",
+		"@johndoe",
+		"johndoe",
+	} {
+		user, _, err := client.Users.GetByURL(context.Background(), input)
+		if err != nil {
+			t.Errorf("Users.GetByURL(%q) returned unexpected error: %v", input, err)
+		}
+
+		want := &User{Name: "Test"}
+		if !reflect.DeepEqual(user, want) {
+			t.Errorf("Users.GetByURL(%q) returned %+v, want %+v", input, user, want)
+		}
+	}
+}
+
+func TestUsersService_GetByURL_invalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request to %v", r.URL)
+	})
+
+	for _, input := range []string{
+		"",
+		"https://example.com/johndoe",
+		"https://vimeo.com/",
+		"john doe",
+		"https://vimeo.com/76979871",
+		"https://vimeo.com/johndoe/videos",
+		"https://vimeo.com/channels/staffpicks",
+		"https://vimeo.com/groups",
+		"vimeo.com/album/123",
+		"https://vimeo.com/showcase/123",
+		"https://vimeo.com/ondemand/film",
+		"76979871",
+	} {
+		if _, _, err := client.Users.GetByURL(context.Background(), input); err == nil {
+			t.Errorf("Users.GetByURL(%q) expected error", input)
+		}
+	}
+}
+
 func TestUsersService_Edit(t *testing.T) {
 	setup()
 	defer teardown()
@@ -95,7 +450,7 @@ func TestUsersService_Edit(t *testing.T) {
 		fmt.Fprint(w, `{"name": "name"}`)
 	})
 
-	user, _, err := client.Users.Edit("1", input)
+	user, _, err := client.Users.Edit(context.Background(), "1", input)
 	if err != nil {
 		t.Errorf("Users.Edit returned unexpected error: %v", err)
 	}
@@ -126,7 +481,7 @@ func TestUsersService_Edit_authenticatedUser(t *testing.T) {
 		fmt.Fprint(w, `{"name": "name"}`)
 	})
 
-	user, _, err := client.Users.Edit("", input)
+	user, _, err := client.Users.Edit(context.Background(), "", input)
 	if err != nil {
 		t.Errorf("Users.Edit returned unexpected error: %v", err)
 	}
@@ -137,6 +492,107 @@ func TestUsersService_Edit_authenticatedUser(t *testing.T) {
 	}
 }
 
+func TestUser_SocialLinks(t *testing.T) {
+	u := User{WebSites: []*WebSite{
+		{Type: "facebook", Link: "https://facebook.com/test"},
+		{Link: "https://www.twitter.com/test"},
+		{Type: "link", Link: "https://example.com"},
+	}}
+
+	want := &SocialLinks{
+		Facebook: "https://facebook.com/test",
+		Twitter:  "https://www.twitter.com/test",
+		Other:    []*WebSite{{Type: "link", Link: "https://example.com"}},
+	}
+	if got := u.SocialLinks(); !reflect.DeepEqual(got, want) {
+		t.Errorf("User.SocialLinks returned %+v, want %+v", got, want)
+	}
+}
+
+func TestUsersService_AddWebSite(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"websites": [{"link": "https://a.com"}]}`)
+		case "PATCH":
+			body, _ := ioutil.ReadAll(r.Body)
+			want := `{"websites":[{"link":"https://a.com"},{"name":"b","link":"https://b.com"}]}` + "\n"
+			if string(body) != want {
+				t.Errorf("Users.AddWebSite body is %s, want %s", body, want)
+			}
+			fmt.Fprint(w, `{"websites": [{"link": "https://a.com"}, {"name": "b", "link": "https://b.com"}]}`)
+		default:
+			t.Errorf("Unexpected request method %v", r.Method)
+		}
+	})
+
+	user, _, err := client.Users.AddWebSite(context.Background(), "", &WebSite{Name: "b", Link: "https://b.com"})
+	if err != nil {
+		t.Errorf("Users.AddWebSite returned unexpected error: %v", err)
+	}
+
+	want := &User{WebSites: []*WebSite{{Link: "https://a.com"}, {Name: "b", Link: "https://b.com"}}}
+	if !reflect.DeepEqual(user, want) {
+		t.Errorf("Users.AddWebSite returned %+v, want %+v", user, want)
+	}
+}
+
+func TestUsersService_EditWebSite(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"websites": [{"link": "https://a.com"}]}`)
+		case "PATCH":
+			body, _ := ioutil.ReadAll(r.Body)
+			want := `{"websites":[{"link":"https://c.com"}]}` + "\n"
+			if string(body) != want {
+				t.Errorf("Users.EditWebSite body is %s, want %s", body, want)
+			}
+			fmt.Fprint(w, `{}`)
+		}
+	})
+
+	_, _, err := client.Users.EditWebSite(context.Background(), "1", 0, &WebSite{Link: "https://c.com"})
+	if err != nil {
+		t.Errorf("Users.EditWebSite returned unexpected error: %v", err)
+	}
+
+	_, _, err = client.Users.EditWebSite(context.Background(), "1", 1, &WebSite{Link: "https://c.com"})
+	if err == nil {
+		t.Error("Users.EditWebSite expected error for out of range index")
+	}
+}
+
+func TestUsersService_RemoveWebSite(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"websites": [{"link": "https://a.com"}]}`)
+		case "PATCH":
+			body, _ := ioutil.ReadAll(r.Body)
+			want := `{"websites":[]}` + "\n"
+			if string(body) != want {
+				t.Errorf("Users.RemoveWebSite body is %s, want %s", body, want)
+			}
+			fmt.Fprint(w, `{}`)
+		}
+	})
+
+	_, _, err := client.Users.RemoveWebSite(context.Background(), "1", 0)
+	if err != nil {
+		t.Errorf("Users.RemoveWebSite returned unexpected error: %v", err)
+	}
+}
+
 func TestUsersService_ListAlbum(t *testing.T) {
 	setup()
 	defer teardown()
@@ -153,7 +609,7 @@ func TestUsersService_ListAlbum(t *testing.T) {
 	opt := &ListAlbumOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	albums, _, err := client.Users.ListAlbum("1", opt)
+	albums, _, err := client.Users.ListAlbum(context.Background(), "1", opt)
 	if err != nil {
 		t.Errorf("Users.ListAlbum returned unexpected error: %v", err)
 	}
@@ -180,7 +636,7 @@ func TestUsersService_ListAlbum_authenticatedUser(t *testing.T) {
 	opt := &ListAlbumOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	albums, _, err := client.Users.ListAlbum("", opt)
+	albums, _, err := client.Users.ListAlbum(context.Background(), "", opt)
 	if err != nil {
 		t.Errorf("Users.ListAlbum returned unexpected error: %v", err)
 	}
@@ -212,7 +668,7 @@ func TestUsersService_CreateAlbum(t *testing.T) {
 		fmt.Fprint(w, `{"name": "name"}`)
 	})
 
-	album, _, err := client.Users.CreateAlbum("1", input)
+	album, _, err := client.Users.CreateAlbum(context.Background(), "1", input)
 	if err != nil {
 		t.Errorf("Users.CreateAlbum returned unexpected error: %v", err)
 	}
@@ -244,7 +700,7 @@ func TestUsersService_CreateAlbum_authenticatedUser(t *testing.T) {
 		fmt.Fprint(w, `{"name": "name"}`)
 	})
 
-	album, _, err := client.Users.CreateAlbum("", input)
+	album, _, err := client.Users.CreateAlbum(context.Background(), "", input)
 	if err != nil {
 		t.Errorf("Users.CreateAlbum returned unexpected error: %v", err)
 	}
@@ -264,7 +720,7 @@ func TestUsersService_GetAlbum(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	album, _, err := client.Users.GetAlbum("1", "a")
+	album, _, err := client.Users.GetAlbum(context.Background(), "1", "a")
 	if err != nil {
 		t.Errorf("Users.GetAlbum returned unexpected error: %v", err)
 	}
@@ -284,7 +740,7 @@ func TestUsersService_GetAlbum_authenticatedUser(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	album, _, err := client.Users.GetAlbum("", "a")
+	album, _, err := client.Users.GetAlbum(context.Background(), "", "a")
 	if err != nil {
 		t.Errorf("Users.GetAlbum returned unexpected error: %v", err)
 	}
@@ -317,7 +773,7 @@ func TestUsersService_EditAlbum(t *testing.T) {
 		fmt.Fprint(w, `{"name": "name"}`)
 	})
 
-	album, _, err := client.Users.EditAlbum("1", "a", input)
+	album, _, err := client.Users.EditAlbum(context.Background(), "1", "a", input)
 	if err != nil {
 		t.Errorf("Users.Edit returned unexpected error: %v", err)
 	}
@@ -350,7 +806,7 @@ func TestUsersService_EditAlbum_authenticatedUser(t *testing.T) {
 		fmt.Fprint(w, `{"name": "name"}`)
 	})
 
-	album, _, err := client.Users.EditAlbum("", "a", input)
+	album, _, err := client.Users.EditAlbum(context.Background(), "", "a", input)
 	if err != nil {
 		t.Errorf("Users.Edit returned unexpected error: %v", err)
 	}
@@ -361,6 +817,111 @@ func TestUsersService_EditAlbum_authenticatedUser(t *testing.T) {
 	}
 }
 
+func TestUsersService_EditAlbum_themeLayout(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &AlbumRequest{
+		Theme:      "dark",
+		Layout:     "player",
+		BrandColor: "#00adef",
+	}
+
+	mux.HandleFunc("/users/1/albums/a", func(w http.ResponseWriter, r *http.Request) {
+		v := &AlbumRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PATCH")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Users.EditAlbum body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"theme": "dark", "layout": "player", "brand_color": "#00adef"}`)
+	})
+
+	album, _, err := client.Users.EditAlbum(context.Background(), "1", "a", input)
+	if err != nil {
+		t.Errorf("Users.EditAlbum returned unexpected error: %v", err)
+	}
+
+	want := &Album{Theme: "dark", Layout: "player", BrandColor: "#00adef"}
+	if !reflect.DeepEqual(album, want) {
+		t.Errorf("Users.EditAlbum returned %+v, want %+v", album, want)
+	}
+}
+
+func TestUsersService_EditAlbum_invalidRequest(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for _, input := range []*AlbumRequest{
+		{Theme: "light"},
+		{Layout: "list"},
+		{BrandColor: "blue"},
+		{CustomURL: "My Showcase"},
+		{CustomURL: "trailing-"},
+	} {
+		_, _, err := client.Users.EditAlbum(context.Background(), "1", "a", input)
+		if err == nil {
+			t.Errorf("Users.EditAlbum(%+v) expected error", input)
+		}
+	}
+}
+
+func TestUsersService_EditAlbum_customURL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &AlbumRequest{CustomURL: "my-showcase"}
+
+	mux.HandleFunc("/users/1/albums/a", func(w http.ResponseWriter, r *http.Request) {
+		v := &AlbumRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PATCH")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Users.EditAlbum body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"url": "my-showcase"}`)
+	})
+
+	album, _, err := client.Users.EditAlbum(context.Background(), "1", "a", input)
+	if err != nil {
+		t.Errorf("Users.EditAlbum returned unexpected error: %v", err)
+	}
+
+	want := &Album{CustomURL: "my-showcase"}
+	if !reflect.DeepEqual(album, want) {
+		t.Errorf("Users.EditAlbum returned %+v, want %+v", album, want)
+	}
+}
+
+func TestUsersService_EditAlbum_customURLConflict(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/albums/a", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+	})
+
+	_, resp, err := client.Users.EditAlbum(context.Background(), "1", "a", &AlbumRequest{CustomURL: "taken"})
+	if err == nil {
+		t.Fatal("Users.EditAlbum expected error")
+	}
+	if resp.StatusCode != http.StatusConflict {
+		t.Errorf("Users.EditAlbum returned status %d, want %d", resp.StatusCode, http.StatusConflict)
+	}
+	if !strings.Contains(err.Error(), "already taken") {
+		t.Errorf("Users.EditAlbum returned error %q, want conflict error", err)
+	}
+
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Errorf("Users.EditAlbum returned error %T, want wrapped *ErrorResponse", err)
+	}
+}
+
 func TestUsersService_DeleteAlbum(t *testing.T) {
 	setup()
 	defer teardown()
@@ -369,7 +930,7 @@ func TestUsersService_DeleteAlbum(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Users.DeleteAlbum("1", "a")
+	_, err := client.Users.DeleteAlbum(context.Background(), "1", "a")
 	if err != nil {
 		t.Errorf("Users.DeleteAlbum returned unexpected error: %v", err)
 	}
@@ -383,7 +944,7 @@ func TestUsersService_DeleteAlbum_authenticatedUser(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Users.DeleteAlbum("", "a")
+	_, err := client.Users.DeleteAlbum(context.Background(), "", "a")
 	if err != nil {
 		t.Errorf("Users.DeleteAlbum returned unexpected error: %v", err)
 	}
@@ -405,7 +966,7 @@ func TestUsersService_AlbumListVideo(t *testing.T) {
 	opt := &ListVideoOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	videos, _, err := client.Users.AlbumListVideo("1", "a", opt)
+	videos, _, err := client.Users.AlbumListVideo(context.Background(), "1", "a", opt)
 	if err != nil {
 		t.Errorf("Users.AlbumListVideo returned unexpected error: %v", err)
 	}
@@ -432,7 +993,7 @@ func TestUsersService_AlbumListVideo_authenticatedUser(t *testing.T) {
 	opt := &ListVideoOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	videos, _, err := client.Users.AlbumListVideo("", "a", opt)
+	videos, _, err := client.Users.AlbumListVideo(context.Background(), "", "a", opt)
 	if err != nil {
 		t.Errorf("Users.AlbumListVideo returned unexpected error: %v", err)
 	}
@@ -452,7 +1013,7 @@ func TestUsersService_AlbumGetVideo(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	video, _, err := client.Users.AlbumGetVideo("1", "a", 1)
+	video, _, err := client.Users.AlbumGetVideo(context.Background(), "1", "a", 1)
 	if err != nil {
 		t.Errorf("Users.AlbumGetVideo returned unexpected error: %v", err)
 	}
@@ -472,7 +1033,7 @@ func TestUsersService_AlbumGetVideo_authenticatedUser(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	video, _, err := client.Users.AlbumGetVideo("", "a", 1)
+	video, _, err := client.Users.AlbumGetVideo(context.Background(), "", "a", 1)
 	if err != nil {
 		t.Errorf("Users.AlbumGetVideo returned unexpected error: %v", err)
 	}
@@ -491,7 +1052,7 @@ func TestUsersService_AlbumAddVideo(t *testing.T) {
 		testMethod(t, r, "PUT")
 	})
 
-	_, err := client.Users.AlbumAddVideo("1", "a", 1)
+	_, err := client.Users.AlbumAddVideo(context.Background(), "1", "a", 1)
 	if err != nil {
 		t.Errorf("Users.AlbumAddVideo returned unexpected error: %v", err)
 	}
@@ -505,7 +1066,7 @@ func TestUsersService_AlbumAddVideo_authenticatedUser(t *testing.T) {
 		testMethod(t, r, "PUT")
 	})
 
-	_, err := client.Users.AlbumAddVideo("", "a", 1)
+	_, err := client.Users.AlbumAddVideo(context.Background(), "", "a", 1)
 	if err != nil {
 		t.Errorf("Users.AlbumAddVideo returned unexpected error: %v", err)
 	}
@@ -519,7 +1080,7 @@ func TestUsersService_AlbumDeleteVideo(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Users.AlbumDeleteVideo("1", "a", 1)
+	_, err := client.Users.AlbumDeleteVideo(context.Background(), "1", "a", 1)
 	if err != nil {
 		t.Errorf("Users.AlbumDeleteVideo returned unexpected error: %v", err)
 	}
@@ -533,7 +1094,7 @@ func TestUsersService_AlbumDeleteVideo_authenticatedUser(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Users.AlbumDeleteVideo("", "a", 1)
+	_, err := client.Users.AlbumDeleteVideo(context.Background(), "", "a", 1)
 	if err != nil {
 		t.Errorf("Users.AlbumDeleteVideo returned unexpected error: %v", err)
 	}
@@ -555,7 +1116,7 @@ func TestUsersService_ListAppearance(t *testing.T) {
 	opt := &ListVideoOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	videos, _, err := client.Users.ListAppearance("1", opt)
+	videos, _, err := client.Users.ListAppearance(context.Background(), "1", opt)
 	if err != nil {
 		t.Errorf("Users.ListAppearance returned unexpected error: %v", err)
 	}
@@ -582,7 +1143,7 @@ func TestUsersService_ListAppearance_authenticatedUser(t *testing.T) {
 	opt := &ListVideoOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	videos, _, err := client.Users.ListAppearance("", opt)
+	videos, _, err := client.Users.ListAppearance(context.Background(), "", opt)
 	if err != nil {
 		t.Errorf("Users.ListAppearance returned unexpected error: %v", err)
 	}
@@ -609,7 +1170,7 @@ func TestUsersService_ListCategory(t *testing.T) {
 	opt := &ListCategoryOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	categories, _, err := client.Users.ListCategory("1", opt)
+	categories, _, err := client.Users.ListCategory(context.Background(), "1", opt)
 	if err != nil {
 		t.Errorf("Users.ListCategory returned unexpected error: %v", err)
 	}
@@ -636,7 +1197,7 @@ func TestUsersService_ListCategory_authenticatedUser(t *testing.T) {
 	opt := &ListCategoryOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	categories, _, err := client.Users.ListCategory("", opt)
+	categories, _, err := client.Users.ListCategory(context.Background(), "", opt)
 	if err != nil {
 		t.Errorf("Users.ListCategory returned unexpected error: %v", err)
 	}
@@ -655,7 +1216,7 @@ func TestUsersService_SubscribeCategory(t *testing.T) {
 		testMethod(t, r, "PUT")
 	})
 
-	_, err := client.Users.SubscribeCategory("1", "1")
+	_, err := client.Users.SubscribeCategory(context.Background(), "1", "1")
 	if err != nil {
 		t.Errorf("Users.SubscribeCategory returned unexpected error: %v", err)
 	}
@@ -669,7 +1230,7 @@ func TestUsersService_SubscribeCategory_authenticatedUser(t *testing.T) {
 		testMethod(t, r, "PUT")
 	})
 
-	_, err := client.Users.SubscribeCategory("", "1")
+	_, err := client.Users.SubscribeCategory(context.Background(), "", "1")
 	if err != nil {
 		t.Errorf("Users.SubscribeCategory returned unexpected error: %v", err)
 	}
@@ -683,7 +1244,7 @@ func TestUsersService_UnsubscribeCategory(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Users.UnsubscribeCategory("1", "1")
+	_, err := client.Users.UnsubscribeCategory(context.Background(), "1", "1")
 	if err != nil {
 		t.Errorf("Users.UnsubscribeCategory returned unexpected error: %v", err)
 	}
@@ -697,7 +1258,7 @@ func TestUsersService_UnsubscribeCategory_authenticatedUser(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Users.UnsubscribeCategory("", "1")
+	_, err := client.Users.UnsubscribeCategory(context.Background(), "", "1")
 	if err != nil {
 		t.Errorf("Users.UnsubscribeCategory returned unexpected error: %v", err)
 	}
@@ -719,7 +1280,7 @@ func TestUsersService_ListChannel(t *testing.T) {
 	opt := &ListChannelOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	channels, _, err := client.Users.ListChannel("1", opt)
+	channels, _, err := client.Users.ListChannel(context.Background(), "1", opt)
 	if err != nil {
 		t.Errorf("Users.ListChannel returned unexpected error: %v", err)
 	}
@@ -746,7 +1307,7 @@ func TestUsersService_ListChannel_authenticatedUser(t *testing.T) {
 	opt := &ListChannelOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	channels, _, err := client.Users.ListChannel("", opt)
+	channels, _, err := client.Users.ListChannel(context.Background(), "", opt)
 	if err != nil {
 		t.Errorf("Users.ListChannel returned unexpected error: %v", err)
 	}
@@ -765,7 +1326,7 @@ func TestUsersService_SubscribeChannel(t *testing.T) {
 		testMethod(t, r, "PUT")
 	})
 
-	_, err := client.Users.SubscribeChannel("1", "1")
+	_, err := client.Users.SubscribeChannel(context.Background(), "1", "1")
 	if err != nil {
 		t.Errorf("Users.SubscribeChannel returned unexpected error: %v", err)
 	}
@@ -779,7 +1340,7 @@ func TestUsersService_SubscribeChannel_authenticatedUser(t *testing.T) {
 		testMethod(t, r, "PUT")
 	})
 
-	_, err := client.Users.SubscribeChannel("", "1")
+	_, err := client.Users.SubscribeChannel(context.Background(), "", "1")
 	if err != nil {
 		t.Errorf("Users.SubscribeChannel returned unexpected error: %v", err)
 	}
@@ -793,7 +1354,7 @@ func TestUsersService_UnsubscribeChannel(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Users.UnsubscribeChannel("1", "1")
+	_, err := client.Users.UnsubscribeChannel(context.Background(), "1", "1")
 	if err != nil {
 		t.Errorf("Users.UnsubscribeChannel returned unexpected error: %v", err)
 	}
@@ -807,71 +1368,231 @@ func TestUsersService_UnsubscribeChannel_authenticatedUser(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Users.UnsubscribeChannel("", "1")
+	_, err := client.Users.UnsubscribeChannel(context.Background(), "", "1")
+	if err != nil {
+		t.Errorf("Users.UnsubscribeChannel returned unexpected error: %v", err)
+	}
+}
+
+func TestUsersService_Feed(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/feed", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"page":     "1",
+			"per_page": "2",
+		})
+		fmt.Fprint(w, `{"data": [{"uri": "/1"}]}`)
+	})
+
+	opt := &ListFeedOptions{
+		ListOptions: ListOptions{Page: 1, PerPage: 2},
+	}
+	feed, _, err := client.Users.Feed(context.Background(), "1", opt)
+	if err != nil {
+		t.Errorf("Users.Feed returned unexpected error: %v", err)
+	}
+
+	want := []*Feed{{URI: "/1"}}
+	if !reflect.DeepEqual(feed, want) {
+		t.Errorf("Users.Feed returned %+v, want %+v", feed, want)
+	}
+}
+
+func TestUsersService_Feed_type(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/feed", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"type":   "like",
+			"filter": "trending",
+		})
+		fmt.Fprint(w, `{"data": [{"uri": "/1", "type": "like", "user": {"name": "Test"}}]}`)
+	})
+
+	opt := &ListFeedOptions{Type: "like", Filter: "trending"}
+	feed, _, err := client.Users.Feed(context.Background(), "", opt)
+	if err != nil {
+		t.Errorf("Users.Feed returned unexpected error: %v", err)
+	}
+
+	want := []*Feed{{URI: "/1", Type: "like", User: &User{Name: "Test"}}}
+	if !reflect.DeepEqual(feed, want) {
+		t.Errorf("Users.Feed returned %+v, want %+v", feed, want)
+	}
+}
+
+func TestUsersService_Feed_authenticatedUser(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/feed", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"page":     "1",
+			"per_page": "2",
+		})
+		fmt.Fprint(w, `{"data": [{"uri": "/1"}]}`)
+	})
+
+	opt := &ListFeedOptions{
+		ListOptions: ListOptions{Page: 1, PerPage: 2},
+	}
+	feed, _, err := client.Users.Feed(context.Background(), "", opt)
+	if err != nil {
+		t.Errorf("Users.Feed returned unexpected error: %v", err)
+	}
+
+	want := []*Feed{{URI: "/1"}}
+	if !reflect.DeepEqual(feed, want) {
+		t.Errorf("Users.Feed returned %+v, want %+v", feed, want)
+	}
+}
+
+func TestUsersService_ListFollower(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/followers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"page":     "1",
+			"per_page": "2",
+		})
+		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
+	})
+
+	opt := &ListUserOptions{
+		ListOptions: ListOptions{Page: 1, PerPage: 2},
+	}
+	users, _, err := client.Users.ListFollower(context.Background(), "1", opt)
+	if err != nil {
+		t.Errorf("Users.ListFollower returned unexpected error: %v", err)
+	}
+
+	want := []*User{{Name: "Test"}}
+	if !reflect.DeepEqual(users, want) {
+		t.Errorf("Users.ListFollower returned %+v, want %+v", users, want)
+	}
+}
+
+func TestUsersService_ListFollower_pictureSizes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/followers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"fields": "name,pictures.sizes"})
+		fmt.Fprint(w, `{"data": [{"name": "Test", "pictures": {"sizes": [
+			{"width": 30, "height": 30},
+			{"width": 100, "height": 75},
+			{"width": 300, "height": 300}
+		]}}]}`)
+	})
+
+	opt := &ListUserOptions{
+		PictureSizes: []int{100, 300},
+		ListOptions:  ListOptions{Fields: []string{"name"}},
+	}
+	users, _, err := client.Users.ListFollower(context.Background(), "1", opt)
+	if err != nil {
+		t.Errorf("Users.ListFollower returned unexpected error: %v", err)
+	}
+
+	want := []*User{{Name: "Test", Pictures: &Pictures{Sizes: []*PictureSize{
+		{Width: 100, Height: 75},
+		{Width: 300, Height: 300},
+	}}}}
+	if !reflect.DeepEqual(users, want) {
+		t.Errorf("Users.ListFollower returned %+v, want %+v", users, want)
+	}
+	if !reflect.DeepEqual(opt.Fields, []string{"name"}) {
+		t.Errorf("Users.ListFollower modified the fields: %v", opt.Fields)
+	}
+}
+
+func TestUsersService_ListFollower_authenticatedUser(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/followers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"page":     "1",
+			"per_page": "2",
+		})
+		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
+	})
+
+	opt := &ListUserOptions{
+		ListOptions: ListOptions{Page: 1, PerPage: 2},
+	}
+	users, _, err := client.Users.ListFollower(context.Background(), "", opt)
 	if err != nil {
-		t.Errorf("Users.UnsubscribeChannel returned unexpected error: %v", err)
+		t.Errorf("Users.ListFollower returned unexpected error: %v", err)
+	}
+
+	want := []*User{{Name: "Test"}}
+	if !reflect.DeepEqual(users, want) {
+		t.Errorf("Users.ListFollower returned %+v, want %+v", users, want)
 	}
 }
 
-func TestUsersService_Feed(t *testing.T) {
+func TestUsersService_CountFollowers(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/users/1/feed", func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc("/users/1/followers", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
 		testFormValues(t, r, values{
-			"page":     "1",
-			"per_page": "2",
+			"per_page": "1",
+			"fields":   "uri",
 		})
-		fmt.Fprint(w, `{"data": [{"uri": "/1"}]}`)
+		fmt.Fprint(w, `{"total": 7, "data": [{"name": "Test"}]}`)
 	})
 
-	opt := &ListFeedOptions{
-		ListOptions: ListOptions{Page: 1, PerPage: 2},
-	}
-	feed, _, err := client.Users.Feed("1", opt)
+	total, _, err := client.Users.CountFollowers(context.Background(), "1")
 	if err != nil {
-		t.Errorf("Users.Feed returned unexpected error: %v", err)
+		t.Errorf("Users.CountFollowers returned unexpected error: %v", err)
 	}
 
-	want := []*Feed{{URI: "/1"}}
-	if !reflect.DeepEqual(feed, want) {
-		t.Errorf("Users.Feed returned %+v, want %+v", feed, want)
+	if want := 7; total != want {
+		t.Errorf("Users.CountFollowers returned %+v, want %+v", total, want)
 	}
 }
 
-func TestUsersService_Feed_authenticatedUser(t *testing.T) {
+func TestUsersService_CountFollowers_authenticatedUser(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/me/feed", func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc("/me/followers", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
 		testFormValues(t, r, values{
-			"page":     "1",
-			"per_page": "2",
+			"per_page": "1",
+			"fields":   "uri",
 		})
-		fmt.Fprint(w, `{"data": [{"uri": "/1"}]}`)
+		fmt.Fprint(w, `{"total": 7, "data": [{"name": "Test"}]}`)
 	})
 
-	opt := &ListFeedOptions{
-		ListOptions: ListOptions{Page: 1, PerPage: 2},
-	}
-	feed, _, err := client.Users.Feed("", opt)
+	total, _, err := client.Users.CountFollowers(context.Background(), "")
 	if err != nil {
-		t.Errorf("Users.Feed returned unexpected error: %v", err)
+		t.Errorf("Users.CountFollowers returned unexpected error: %v", err)
 	}
 
-	want := []*Feed{{URI: "/1"}}
-	if !reflect.DeepEqual(feed, want) {
-		t.Errorf("Users.Feed returned %+v, want %+v", feed, want)
+	if want := 7; total != want {
+		t.Errorf("Users.CountFollowers returned %+v, want %+v", total, want)
 	}
 }
 
-func TestUsersService_ListFollower(t *testing.T) {
+func TestUsersService_ListFollowed(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/users/1/followers", func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc("/users/1/following", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
 		testFormValues(t, r, values{
 			"page":     "1",
@@ -883,22 +1604,22 @@ func TestUsersService_ListFollower(t *testing.T) {
 	opt := &ListUserOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	users, _, err := client.Users.ListFollower("1", opt)
+	users, _, err := client.Users.ListFollowed(context.Background(), "1", opt)
 	if err != nil {
-		t.Errorf("Users.ListFollower returned unexpected error: %v", err)
+		t.Errorf("Users.ListFollowed returned unexpected error: %v", err)
 	}
 
 	want := []*User{{Name: "Test"}}
 	if !reflect.DeepEqual(users, want) {
-		t.Errorf("Users.ListFollower returned %+v, want %+v", users, want)
+		t.Errorf("Users.ListFollowed returned %+v, want %+v", users, want)
 	}
 }
 
-func TestUsersService_ListFollower_authenticatedUser(t *testing.T) {
+func TestUsersService_ListFollowed_authenticatedUser(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/me/followers", func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc("/me/following", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
 		testFormValues(t, r, values{
 			"page":     "1",
@@ -910,68 +1631,60 @@ func TestUsersService_ListFollower_authenticatedUser(t *testing.T) {
 	opt := &ListUserOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	users, _, err := client.Users.ListFollower("", opt)
+	users, _, err := client.Users.ListFollowed(context.Background(), "", opt)
 	if err != nil {
-		t.Errorf("Users.ListFollower returned unexpected error: %v", err)
+		t.Errorf("Users.ListFollowed returned unexpected error: %v", err)
 	}
 
 	want := []*User{{Name: "Test"}}
 	if !reflect.DeepEqual(users, want) {
-		t.Errorf("Users.ListFollower returned %+v, want %+v", users, want)
+		t.Errorf("Users.ListFollowed returned %+v, want %+v", users, want)
 	}
 }
 
-func TestUsersService_ListFollowed(t *testing.T) {
+func TestUsersService_CountFollowing(t *testing.T) {
 	setup()
 	defer teardown()
 
 	mux.HandleFunc("/users/1/following", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
 		testFormValues(t, r, values{
-			"page":     "1",
-			"per_page": "2",
+			"per_page": "1",
+			"fields":   "uri",
 		})
-		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
+		fmt.Fprint(w, `{"total": 7, "data": [{"name": "Test"}]}`)
 	})
 
-	opt := &ListUserOptions{
-		ListOptions: ListOptions{Page: 1, PerPage: 2},
-	}
-	users, _, err := client.Users.ListFollowed("1", opt)
+	total, _, err := client.Users.CountFollowing(context.Background(), "1")
 	if err != nil {
-		t.Errorf("Users.ListFollowed returned unexpected error: %v", err)
+		t.Errorf("Users.CountFollowing returned unexpected error: %v", err)
 	}
 
-	want := []*User{{Name: "Test"}}
-	if !reflect.DeepEqual(users, want) {
-		t.Errorf("Users.ListFollowed returned %+v, want %+v", users, want)
+	if want := 7; total != want {
+		t.Errorf("Users.CountFollowing returned %+v, want %+v", total, want)
 	}
 }
 
-func TestUsersService_ListFollowed_authenticatedUser(t *testing.T) {
+func TestUsersService_CountFollowing_authenticatedUser(t *testing.T) {
 	setup()
 	defer teardown()
 
 	mux.HandleFunc("/me/following", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
 		testFormValues(t, r, values{
-			"page":     "1",
-			"per_page": "2",
+			"per_page": "1",
+			"fields":   "uri",
 		})
-		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
+		fmt.Fprint(w, `{"total": 7, "data": [{"name": "Test"}]}`)
 	})
 
-	opt := &ListUserOptions{
-		ListOptions: ListOptions{Page: 1, PerPage: 2},
-	}
-	users, _, err := client.Users.ListFollowed("", opt)
+	total, _, err := client.Users.CountFollowing(context.Background(), "")
 	if err != nil {
-		t.Errorf("Users.ListFollowed returned unexpected error: %v", err)
+		t.Errorf("Users.CountFollowing returned unexpected error: %v", err)
 	}
 
-	want := []*User{{Name: "Test"}}
-	if !reflect.DeepEqual(users, want) {
-		t.Errorf("Users.ListFollowed returned %+v, want %+v", users, want)
+	if want := 7; total != want {
+		t.Errorf("Users.CountFollowing returned %+v, want %+v", total, want)
 	}
 }
 
@@ -983,7 +1696,7 @@ func TestUsersService_FollowUser(t *testing.T) {
 		testMethod(t, r, "PUT")
 	})
 
-	_, err := client.Users.FollowUser("1", "2")
+	_, err := client.Users.FollowUser(context.Background(), "1", "2")
 	if err != nil {
 		t.Errorf("Users.FollowUser returned unexpected error: %v", err)
 	}
@@ -997,7 +1710,7 @@ func TestUsersService_FollowUser_authenticatedUser(t *testing.T) {
 		testMethod(t, r, "PUT")
 	})
 
-	_, err := client.Users.FollowUser("", "2")
+	_, err := client.Users.FollowUser(context.Background(), "", "2")
 	if err != nil {
 		t.Errorf("Users.FollowUser returned unexpected error: %v", err)
 	}
@@ -1011,7 +1724,7 @@ func TestUsersService_UnfollowUser(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Users.UnfollowUser("1", "2")
+	_, err := client.Users.UnfollowUser(context.Background(), "1", "2")
 	if err != nil {
 		t.Errorf("Users.UnfollowUser returned unexpected error: %v", err)
 	}
@@ -1025,7 +1738,7 @@ func TestUsersService_UnfollowUser_authenticatedUser(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Users.UnfollowUser("", "2")
+	_, err := client.Users.UnfollowUser(context.Background(), "", "2")
 	if err != nil {
 		t.Errorf("Users.UnfollowUser returned unexpected error: %v", err)
 	}
@@ -1047,7 +1760,7 @@ func TestUsersService_ListGroup(t *testing.T) {
 	opt := &ListGroupOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	groups, _, err := client.Users.ListGroup("1", opt)
+	groups, _, err := client.Users.ListGroup(context.Background(), "1", opt)
 	if err != nil {
 		t.Errorf("Users.ListGroup returned unexpected error: %v", err)
 	}
@@ -1074,7 +1787,7 @@ func TestUsersService_ListGroup_authenticatedUser(t *testing.T) {
 	opt := &ListGroupOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	groups, _, err := client.Users.ListGroup("", opt)
+	groups, _, err := client.Users.ListGroup(context.Background(), "", opt)
 	if err != nil {
 		t.Errorf("Users.ListGroup returned unexpected error: %v", err)
 	}
@@ -1093,7 +1806,7 @@ func TestUsersService_JoinGroup(t *testing.T) {
 		testMethod(t, r, "PUT")
 	})
 
-	_, err := client.Users.JoinGroup("1", "1")
+	_, err := client.Users.JoinGroup(context.Background(), "1", "1")
 	if err != nil {
 		t.Errorf("Users.JoinGroup returned unexpected error: %v", err)
 	}
@@ -1107,7 +1820,7 @@ func TestUsersService_JoinGroup_authenticatedUser(t *testing.T) {
 		testMethod(t, r, "PUT")
 	})
 
-	_, err := client.Users.JoinGroup("", "1")
+	_, err := client.Users.JoinGroup(context.Background(), "", "1")
 	if err != nil {
 		t.Errorf("Users.JoinGroup returned unexpected error: %v", err)
 	}
@@ -1121,7 +1834,7 @@ func TestUsersService_LeaveGroup(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Users.LeaveGroup("1", "1")
+	_, err := client.Users.LeaveGroup(context.Background(), "1", "1")
 	if err != nil {
 		t.Errorf("Users.LeaveGroup returned unexpected error: %v", err)
 	}
@@ -1135,7 +1848,7 @@ func TestUsersService_LeaveGroup_authenticatedUser(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Users.LeaveGroup("", "1")
+	_, err := client.Users.LeaveGroup(context.Background(), "", "1")
 	if err != nil {
 		t.Errorf("Users.LeaveGroup returned unexpected error: %v", err)
 	}
@@ -1157,7 +1870,7 @@ func TestUsersService_ListLikedVideo(t *testing.T) {
 	opt := &ListVideoOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	videos, _, err := client.Users.ListLikedVideo("1", opt)
+	videos, _, err := client.Users.ListLikedVideo(context.Background(), "1", opt)
 	if err != nil {
 		t.Errorf("Users.ListLikedVideo returned unexpected error: %v", err)
 	}
@@ -1184,7 +1897,7 @@ func TestUsersService_ListLikedVideo_authenticatedUser(t *testing.T) {
 	opt := &ListVideoOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	videos, _, err := client.Users.ListLikedVideo("", opt)
+	videos, _, err := client.Users.ListLikedVideo(context.Background(), "", opt)
 	if err != nil {
 		t.Errorf("Users.ListLikedVideo returned unexpected error: %v", err)
 	}
@@ -1195,6 +1908,80 @@ func TestUsersService_ListLikedVideo_authenticatedUser(t *testing.T) {
 	}
 }
 
+func TestUsersService_ListLikedVideo_embeddable(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/likes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"filter":            "embeddable",
+			"filter_embeddable": "true",
+		})
+		fmt.Fprint(w, `{"data": [{"name": "Test", "privacy": {"embed": "public"}}]}`)
+	})
+
+	opt := &ListVideoOptions{
+		Filter:           "embeddable",
+		FilterEmbeddable: Bool(true),
+	}
+	videos, _, err := client.Users.ListLikedVideo(context.Background(), "", opt)
+	if err != nil {
+		t.Errorf("Users.ListLikedVideo returned unexpected error: %v", err)
+	}
+
+	want := []*Video{{Name: "Test", Privacy: &Privacy{Embed: "public"}}}
+	if !reflect.DeepEqual(videos, want) {
+		t.Errorf("Users.ListLikedVideo returned %+v, want %+v", videos, want)
+	}
+}
+
+func TestUsersService_CountLikes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/likes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"per_page": "1",
+			"fields":   "uri",
+		})
+		fmt.Fprint(w, `{"total": 7, "data": [{"name": "Test"}]}`)
+	})
+
+	total, _, err := client.Users.CountLikes(context.Background(), "1")
+	if err != nil {
+		t.Errorf("Users.CountLikes returned unexpected error: %v", err)
+	}
+
+	if want := 7; total != want {
+		t.Errorf("Users.CountLikes returned %+v, want %+v", total, want)
+	}
+}
+
+func TestUsersService_CountLikes_authenticatedUser(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/likes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"per_page": "1",
+			"fields":   "uri",
+		})
+		fmt.Fprint(w, `{"total": 7, "data": [{"name": "Test"}]}`)
+	})
+
+	total, _, err := client.Users.CountLikes(context.Background(), "")
+	if err != nil {
+		t.Errorf("Users.CountLikes returned unexpected error: %v", err)
+	}
+
+	if want := 7; total != want {
+		t.Errorf("Users.CountLikes returned %+v, want %+v", total, want)
+	}
+}
+
 func TestUsersService_LikeVideo(t *testing.T) {
 	setup()
 	defer teardown()
@@ -1203,7 +1990,7 @@ func TestUsersService_LikeVideo(t *testing.T) {
 		testMethod(t, r, "PUT")
 	})
 
-	_, err := client.Users.LikeVideo("1", 1)
+	_, err := client.Users.LikeVideo(context.Background(), "1", 1)
 	if err != nil {
 		t.Errorf("Users.LikeVideo returned unexpected error: %v", err)
 	}
@@ -1217,12 +2004,32 @@ func TestUsersService_LikeVideo_authenticatedUser(t *testing.T) {
 		testMethod(t, r, "PUT")
 	})
 
-	_, err := client.Users.LikeVideo("", 1)
+	_, err := client.Users.LikeVideo(context.Background(), "", 1)
 	if err != nil {
 		t.Errorf("Users.LikeVideo returned unexpected error: %v", err)
 	}
 }
 
+func TestUsersService_LikeVideo_alreadyLiked(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/likes/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"error": "already liked"}`)
+	})
+
+	_, err := client.Users.LikeVideo(context.Background(), "", 1)
+	if err == nil || !strings.Contains(err.Error(), "video 1 is already liked") {
+		t.Errorf("Users.LikeVideo returned error %v, want already liked error", err)
+	}
+
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Errorf("Users.LikeVideo error %v does not wrap *ErrorResponse", err)
+	}
+}
+
 func TestUsersService_UnlikeVideo(t *testing.T) {
 	setup()
 	defer teardown()
@@ -1231,7 +2038,7 @@ func TestUsersService_UnlikeVideo(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Users.UnlikeVideo("1", 1)
+	_, err := client.Users.UnlikeVideo(context.Background(), "1", 1)
 	if err != nil {
 		t.Errorf("Users.UnlikeVideo returned unexpected error: %v", err)
 	}
@@ -1245,7 +2052,7 @@ func TestUsersService_UnlikeVideo_authenticatedUser(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Users.UnlikeVideo("", 1)
+	_, err := client.Users.UnlikeVideo(context.Background(), "", 1)
 	if err != nil {
 		t.Errorf("Users.UnlikeVideo returned unexpected error: %v", err)
 	}
@@ -1259,12 +2066,63 @@ func TestUsersService_RemovePortrait(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Users.RemovePortrait("1", "1")
+	_, err := client.Users.RemovePortrait(context.Background(), "1", "1")
 	if err != nil {
 		t.Errorf("Users.RemovePortrait returned unexpected error: %v", err)
 	}
 }
 
+func TestUsersService_UploadAvatar(t *testing.T) {
+	setup()
+	defer teardown()
+
+	img := "\x89PNG\r\n\x1a\nimage"
+
+	mux.HandleFunc("/me/pictures", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprintf(w, `{"uri": "/users/1/pictures/2", "link": "%s/upload"}`, server.URL)
+	})
+
+	mux.HandleFunc("/upload", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != img {
+			t.Errorf("Users.UploadAvatar uploaded %q, want %q", body, img)
+		}
+	})
+
+	mux.HandleFunc("/users/1/pictures/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		fmt.Fprint(w, `{"uri": "/users/1/pictures/2", "active": true}`)
+	})
+
+	pictures, _, err := client.Users.UploadAvatar(context.Background(), "", strings.NewReader(img))
+	if err != nil {
+		t.Errorf("Users.UploadAvatar returned unexpected error: %v", err)
+	}
+
+	want := &Pictures{URI: "/users/1/pictures/2", Active: true, Link: fmt.Sprintf("%s/upload", server.URL)}
+	if !reflect.DeepEqual(pictures, want) {
+		t.Errorf("Users.UploadAvatar returned %+v, want %+v", pictures, want)
+	}
+}
+
+func TestUsersService_UploadAvatar_invalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request to %v", r.URL)
+	})
+
+	tooLarge := io.MultiReader(strings.NewReader("\xff\xd8\xff"), bytes.NewReader(make([]byte, maxAvatarSize)))
+	for _, img := range []io.Reader{strings.NewReader("GIF89a"), tooLarge} {
+		if _, _, err := client.Users.UploadAvatar(context.Background(), "1", img); err == nil {
+			t.Error("Users.UploadAvatar expected error to be returned")
+		}
+	}
+}
+
 func TestUsersService_RemovePortrait_authenticatedUser(t *testing.T) {
 	setup()
 	defer teardown()
@@ -1273,7 +2131,7 @@ func TestUsersService_RemovePortrait_authenticatedUser(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Users.RemovePortrait("", "1")
+	_, err := client.Users.RemovePortrait(context.Background(), "", "1")
 	if err != nil {
 		t.Errorf("Users.RemovePortrait returned unexpected error: %v", err)
 	}
@@ -1295,7 +2153,7 @@ func TestUsersService_ListPortfolio(t *testing.T) {
 	opt := &ListPortfolioOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	portfolios, _, err := client.Users.ListPortfolio("1", opt)
+	portfolios, _, err := client.Users.ListPortfolio(context.Background(), "1", opt)
 	if err != nil {
 		t.Errorf("Users.ListPortfolio returned unexpected error: %v", err)
 	}
@@ -1322,7 +2180,7 @@ func TestUsersService_ListPortfolio_authenticatedUser(t *testing.T) {
 	opt := &ListPortfolioOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	portfolios, _, err := client.Users.ListPortfolio("", opt)
+	portfolios, _, err := client.Users.ListPortfolio(context.Background(), "", opt)
 	if err != nil {
 		t.Errorf("Users.ListPortfolio returned unexpected error: %v", err)
 	}
@@ -1342,7 +2200,7 @@ func TestUsersService_GetProtfolio(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	portfolio, _, err := client.Users.GetProtfolio("1", "1")
+	portfolio, _, err := client.Users.GetProtfolio(context.Background(), "1", "1")
 	if err != nil {
 		t.Errorf("Users.GetProtfolio returned unexpected error: %v", err)
 	}
@@ -1362,7 +2220,7 @@ func TestUsersService_GetProtfolio_authenticatedUser(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	portfolio, _, err := client.Users.GetProtfolio("", "1")
+	portfolio, _, err := client.Users.GetProtfolio(context.Background(), "", "1")
 	if err != nil {
 		t.Errorf("Users.GetProtfolio returned unexpected error: %v", err)
 	}
@@ -1389,7 +2247,7 @@ func TestUsersService_ProtfolioListVideo(t *testing.T) {
 	opt := &ListVideoOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	videos, _, err := client.Users.ProtfolioListVideo("1", "1", opt)
+	videos, _, err := client.Users.ProtfolioListVideo(context.Background(), "1", "1", opt)
 	if err != nil {
 		t.Errorf("Users.ProtfolioListVideo returned unexpected error: %v", err)
 	}
@@ -1416,7 +2274,7 @@ func TestUsersService_ProtfolioListVideo_authenticatedUser(t *testing.T) {
 	opt := &ListVideoOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	videos, _, err := client.Users.ProtfolioListVideo("", "1", opt)
+	videos, _, err := client.Users.ProtfolioListVideo(context.Background(), "", "1", opt)
 	if err != nil {
 		t.Errorf("Users.ProtfolioListVideo returned unexpected error: %v", err)
 	}
@@ -1436,7 +2294,7 @@ func TestUsersService_ProtfolioGetVideo(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	video, _, err := client.Users.ProtfolioGetVideo("1", "1", 1)
+	video, _, err := client.Users.ProtfolioGetVideo(context.Background(), "1", "1", 1)
 	if err != nil {
 		t.Errorf("Users.ProtfolioGetVideo returned unexpected error: %v", err)
 	}
@@ -1456,7 +2314,7 @@ func TestUsersService_ProtfolioGetVideo_authenticatedUser(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	video, _, err := client.Users.ProtfolioGetVideo("", "1", 1)
+	video, _, err := client.Users.ProtfolioGetVideo(context.Background(), "", "1", 1)
 	if err != nil {
 		t.Errorf("Users.ProtfolioGetVideo returned unexpected error: %v", err)
 	}
@@ -1475,7 +2333,7 @@ func TestUsersService_ProtfolioAddVideo(t *testing.T) {
 		testMethod(t, r, "PUT")
 	})
 
-	_, err := client.Users.ProtfolioAddVideo("1", "1", 1)
+	_, err := client.Users.ProtfolioAddVideo(context.Background(), "1", "1", 1)
 	if err != nil {
 		t.Errorf("Users.ProtfolioDeleteVideo returned unexpected error: %v", err)
 	}
@@ -1489,7 +2347,7 @@ func TestUsersService_ProtfolioAddVideo_authenticatedUser(t *testing.T) {
 		testMethod(t, r, "PUT")
 	})
 
-	_, err := client.Users.ProtfolioAddVideo("", "1", 1)
+	_, err := client.Users.ProtfolioAddVideo(context.Background(), "", "1", 1)
 	if err != nil {
 		t.Errorf("Users.ProtfolioDeleteVideo returned unexpected error: %v", err)
 	}
@@ -1503,7 +2361,7 @@ func TestUsersService_ProtfolioDeleteVideo(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Users.ProtfolioDeleteVideo("1", "1", 1)
+	_, err := client.Users.ProtfolioDeleteVideo(context.Background(), "1", "1", 1)
 	if err != nil {
 		t.Errorf("Users.ProtfolioDeleteVideo returned unexpected error: %v", err)
 	}
@@ -1517,7 +2375,7 @@ func TestUsersService_ProtfolioDeleteVideo_authenticatedUser(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Users.ProtfolioDeleteVideo("", "1", 1)
+	_, err := client.Users.ProtfolioDeleteVideo(context.Background(), "", "1", 1)
 	if err != nil {
 		t.Errorf("Users.ProtfolioDeleteVideo returned unexpected error: %v", err)
 	}
@@ -1539,7 +2397,7 @@ func TestUsersService_ListPreset(t *testing.T) {
 	opt := &ListPresetOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	presets, _, err := client.Users.ListPreset("1", opt)
+	presets, _, err := client.Users.ListPreset(context.Background(), "1", opt)
 	if err != nil {
 		t.Errorf("Users.ListPreset returned unexpected error: %v", err)
 	}
@@ -1566,7 +2424,7 @@ func TestUsersService_ListPreset_authenticatedUser(t *testing.T) {
 	opt := &ListPresetOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	presets, _, err := client.Users.ListPreset("", opt)
+	presets, _, err := client.Users.ListPreset(context.Background(), "", opt)
 	if err != nil {
 		t.Errorf("Users.ListPreset returned unexpected error: %v", err)
 	}
@@ -1586,7 +2444,7 @@ func TestUsersService_GetPreset(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	preset, _, err := client.Users.GetPreset("1", 1)
+	preset, _, err := client.Users.GetPreset(context.Background(), "1", 1)
 	if err != nil {
 		t.Errorf("Users.GetPreset returned unexpected error: %v", err)
 	}
@@ -1606,7 +2464,7 @@ func TestUsersService_GetPreset_authenticatedUser(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	preset, _, err := client.Users.GetPreset("", 1)
+	preset, _, err := client.Users.GetPreset(context.Background(), "", 1)
 	if err != nil {
 		t.Errorf("Users.GetPreset returned unexpected error: %v", err)
 	}
@@ -1633,7 +2491,7 @@ func TestUsersService_PresetListVideo(t *testing.T) {
 	opt := &ListVideoOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	videos, _, err := client.Users.PresetListVideo("1", 1, opt)
+	videos, _, err := client.Users.PresetListVideo(context.Background(), "1", 1, opt)
 	if err != nil {
 		t.Errorf("Users.PresetListVideo returned unexpected error: %v", err)
 	}
@@ -1660,7 +2518,7 @@ func TestUsersService_PresetListVideo_authenticatedUser(t *testing.T) {
 	opt := &ListVideoOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	videos, _, err := client.Users.PresetListVideo("", 1, opt)
+	videos, _, err := client.Users.PresetListVideo(context.Background(), "", 1, opt)
 	if err != nil {
 		t.Errorf("Users.PresetListVideo returned unexpected error: %v", err)
 	}
@@ -1687,7 +2545,7 @@ func TestUsersService_ListVideo(t *testing.T) {
 	opt := &ListVideoOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	videos, _, err := client.Users.ListVideo("1", opt)
+	videos, _, err := client.Users.ListVideo(context.Background(), "1", opt)
 	if err != nil {
 		t.Errorf("Users.ListVideo returned unexpected error: %v", err)
 	}
@@ -1714,7 +2572,7 @@ func TestUsersService_ListVideo_authenticatedUser(t *testing.T) {
 	opt := &ListVideoOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	videos, _, err := client.Users.ListVideo("", opt)
+	videos, _, err := client.Users.ListVideo(context.Background(), "", opt)
 	if err != nil {
 		t.Errorf("Users.ListVideo returned unexpected error: %v", err)
 	}
@@ -1725,6 +2583,84 @@ func TestUsersService_ListVideo_authenticatedUser(t *testing.T) {
 	}
 }
 
+func TestUsersService_CountVideos(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"per_page": "1",
+			"fields":   "uri",
+		})
+		fmt.Fprint(w, `{"total": 7, "data": [{"name": "Test"}]}`)
+	})
+
+	total, _, err := client.Users.CountVideos(context.Background(), "1")
+	if err != nil {
+		t.Errorf("Users.CountVideos returned unexpected error: %v", err)
+	}
+
+	if want := 7; total != want {
+		t.Errorf("Users.CountVideos returned %+v, want %+v", total, want)
+	}
+}
+
+func TestUsersService_CountVideos_authenticatedUser(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"per_page": "1",
+			"fields":   "uri",
+		})
+		fmt.Fprint(w, `{"total": 7, "data": [{"name": "Test"}]}`)
+	})
+
+	total, _, err := client.Users.CountVideos(context.Background(), "")
+	if err != nil {
+		t.Errorf("Users.CountVideos returned unexpected error: %v", err)
+	}
+
+	if want := 7; total != want {
+		t.Errorf("Users.CountVideos returned %+v, want %+v", total, want)
+	}
+}
+
+func TestUsersService_WalkSince(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "1":
+			fmt.Fprint(w, `{"data": [{"name": "3", "created_time": "2017-01-03T00:00:00Z"}], "paging": {"next": "/users/1/videos?page=2"}}`)
+		case "2":
+			fmt.Fprint(w, `{"data": [{"name": "2", "created_time": "2017-01-02T00:00:00Z"}, {"name": "1", "created_time": "2017-01-01T00:00:00Z"}], "paging": {"next": "/users/1/videos?page=3"}}`)
+		default:
+			t.Errorf("Users.WalkSince requested unexpected page %v", r.FormValue("page"))
+		}
+	})
+
+	var names []string
+	since := time.Date(2017, 1, 2, 0, 0, 0, 0, time.UTC)
+	err := client.Users.WalkSince(context.Background(), "1", since, func(v *Video) error {
+		names = append(names, v.Name)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Users.WalkSince returned unexpected error: %v", err)
+	}
+
+	want := []string{"3", "2"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("Users.WalkSince visited %+v, want %+v", names, want)
+	}
+}
+
 func TestUsersService_GetVideo(t *testing.T) {
 	setup()
 	defer teardown()
@@ -1734,7 +2670,7 @@ func TestUsersService_GetVideo(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	video, _, err := client.Users.GetVideo("1", 1)
+	video, _, err := client.Users.GetVideo(context.Background(), "1", 1)
 	if err != nil {
 		t.Errorf("Users.GetVideo returned unexpected error: %v", err)
 	}
@@ -1754,7 +2690,7 @@ func TestUsersService_GetVideo_authenticatedUser(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	video, _, err := client.Users.GetVideo("", 1)
+	video, _, err := client.Users.GetVideo(context.Background(), "", 1)
 	if err != nil {
 		t.Errorf("Users.GetVideo returned unexpected error: %v", err)
 	}
@@ -1765,6 +2701,67 @@ func TestUsersService_GetVideo_authenticatedUser(t *testing.T) {
 	}
 }
 
+func TestUsersService_UploadVideo_apiVersion(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.APIVersion = "3.4"
+	legacy := "application/vnd.vimeo.*+json;version=3.2"
+
+	content := "video"
+	mux.HandleFunc("/me/videos", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Accept", legacy)
+		fmt.Fprintf(w, `{"upload_link_secure": "%s/upload", "complete_uri": "/complete"}`, server.URL)
+	})
+
+	mux.HandleFunc("/upload", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Range") == "bytes */*" {
+			w.Header().Set("Range", fmt.Sprintf("bytes=0-%d", len(content)))
+		}
+	})
+
+	mux.HandleFunc("/complete", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Accept", legacy)
+		w.Header().Set("Location", "/videos/1")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Accept", legacy)
+		fmt.Fprint(w, `{"name": "Test"}`)
+	})
+
+	f, err := ioutil.TempFile("", "go-vimeo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	f.WriteString(content)
+	f.Seek(0, io.SeekStart)
+
+	if _, _, err := client.Users.UploadVideo(context.Background(), "", f); err != nil {
+		t.Errorf("Users.UploadVideo returned unexpected error: %v", err)
+	}
+}
+
+func TestUsersService_UploadVideoByURL_apiVersion(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.APIVersion = "3.4"
+
+	mux.HandleFunc("/me/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", "application/vnd.vimeo.*+json;version=3.2")
+		fmt.Fprint(w, `{"name": "Test"}`)
+	})
+
+	if _, _, err := client.Users.UploadVideoByURL(context.Background(), "", "http://video.com/1.mp4"); err != nil {
+		t.Errorf("Users.UploadVideoByURL returned unexpected error: %v", err)
+	}
+}
+
 func TestUsersService_WatchLaterListVideo(t *testing.T) {
 	setup()
 	defer teardown()
@@ -1781,7 +2778,7 @@ func TestUsersService_WatchLaterListVideo(t *testing.T) {
 	opt := &ListVideoOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	videos, _, err := client.Users.WatchLaterListVideo("1", opt)
+	videos, _, err := client.Users.WatchLaterListVideo(context.Background(), "1", opt)
 	if err != nil {
 		t.Errorf("Users.WatchLaterListVideo returned unexpected error: %v", err)
 	}
@@ -1808,7 +2805,7 @@ func TestUsersService_WatchLaterListVideo_authenticatedUser(t *testing.T) {
 	opt := &ListVideoOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	videos, _, err := client.Users.WatchLaterListVideo("", opt)
+	videos, _, err := client.Users.WatchLaterListVideo(context.Background(), "", opt)
 	if err != nil {
 		t.Errorf("Users.WatchLaterListVideo returned unexpected error: %v", err)
 	}
@@ -1828,7 +2825,7 @@ func TestUsersService_WatchLaterGetVideo(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	video, _, err := client.Users.WatchLaterGetVideo("1", 1)
+	video, _, err := client.Users.WatchLaterGetVideo(context.Background(), "1", 1)
 	if err != nil {
 		t.Errorf("Users.WatchLaterGetVideo returned unexpected error: %v", err)
 	}
@@ -1848,7 +2845,7 @@ func TestUsersService_WatchLaterGetVideo_authenticatedUser(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	video, _, err := client.Users.WatchLaterGetVideo("", 1)
+	video, _, err := client.Users.WatchLaterGetVideo(context.Background(), "", 1)
 	if err != nil {
 		t.Errorf("Users.WatchLaterGetVideo returned unexpected error: %v", err)
 	}
@@ -1867,7 +2864,7 @@ func TestUsersService_WatchLaterAddVideo(t *testing.T) {
 		testMethod(t, r, "PUT")
 	})
 
-	_, err := client.Users.WatchLaterAddVideo("1", 1)
+	_, err := client.Users.WatchLaterAddVideo(context.Background(), "1", 1)
 	if err != nil {
 		t.Errorf("Users.WatchLaterAddVideo returned unexpected error: %v", err)
 	}
@@ -1881,7 +2878,7 @@ func TestUsersService_WatchLaterAddVideo_authenticatedUser(t *testing.T) {
 		testMethod(t, r, "PUT")
 	})
 
-	_, err := client.Users.WatchLaterAddVideo("", 1)
+	_, err := client.Users.WatchLaterAddVideo(context.Background(), "", 1)
 	if err != nil {
 		t.Errorf("Users.WatchLaterAddVideo returned unexpected error: %v", err)
 	}
@@ -1895,7 +2892,7 @@ func TestUsersService_WatchLaterDeleteVideo(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Users.WatchLaterDeleteVideo("1", 1)
+	_, err := client.Users.WatchLaterDeleteVideo(context.Background(), "1", 1)
 	if err != nil {
 		t.Errorf("Users.WatchLaterDeleteVideo returned unexpected error: %v", err)
 	}
@@ -1909,7 +2906,7 @@ func TestUsersService_WatchLaterDeleteVideo_authenticatedUser(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Users.WatchLaterDeleteVideo("", 1)
+	_, err := client.Users.WatchLaterDeleteVideo(context.Background(), "", 1)
 	if err != nil {
 		t.Errorf("Users.WatchLaterDeleteVideo returned unexpected error: %v", err)
 	}
@@ -1931,7 +2928,7 @@ func TestUsersService_WatchedListVideo(t *testing.T) {
 	opt := &ListVideoOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	videos, _, err := client.Users.WatchedListVideo("", opt)
+	videos, _, err := client.Users.WatchedListVideo(context.Background(), "", opt)
 	if err != nil {
 		t.Errorf("Users.WatchedListVideo returned unexpected error: %v", err)
 	}
@@ -1950,7 +2947,7 @@ func TestUsersService_ClearWatchedList(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Users.ClearWatchedList("")
+	_, err := client.Users.ClearWatchedList(context.Background(), "")
 	if err != nil {
 		t.Errorf("Users.ClearWatchedList returned unexpected error: %v", err)
 	}
@@ -1964,8 +2961,45 @@ func TestUsersService_WatchedDeleteVideo(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Users.WatchedDeleteVideo("", 1)
+	_, err := client.Users.WatchedDeleteVideo(context.Background(), "", 1)
 	if err != nil {
 		t.Errorf("Users.WatchedDeleteVideo returned unexpected error: %v", err)
 	}
 }
+
+func TestUsersService_noContent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		fn   func() (*Response, error)
+	}{
+		{"SubscribeCategory", func() (*Response, error) { return client.Users.SubscribeCategory(ctx, "", "a") }},
+		{"UnsubscribeCategory", func() (*Response, error) { return client.Users.UnsubscribeCategory(ctx, "", "a") }},
+		{"SubscribeChannel", func() (*Response, error) { return client.Users.SubscribeChannel(ctx, "", "a") }},
+		{"UnsubscribeChannel", func() (*Response, error) { return client.Users.UnsubscribeChannel(ctx, "", "a") }},
+		{"FollowUser", func() (*Response, error) { return client.Users.FollowUser(ctx, "", "a") }},
+		{"UnfollowUser", func() (*Response, error) { return client.Users.UnfollowUser(ctx, "", "a") }},
+		{"JoinGroup", func() (*Response, error) { return client.Users.JoinGroup(ctx, "", "a") }},
+		{"LeaveGroup", func() (*Response, error) { return client.Users.LeaveGroup(ctx, "", "a") }},
+		{"LikeVideo", func() (*Response, error) { return client.Users.LikeVideo(ctx, "", 1) }},
+		{"UnlikeVideo", func() (*Response, error) { return client.Users.UnlikeVideo(ctx, "", 1) }},
+	}
+
+	for _, tt := range tests {
+		resp, err := tt.fn()
+		if err != nil {
+			t.Errorf("Users.%s returned unexpected error: %v", tt.name, err)
+			continue
+		}
+		if resp.StatusCode != http.StatusNoContent {
+			t.Errorf("Users.%s returned status %d, want %d", tt.name, resp.StatusCode, http.StatusNoContent)
+		}
+	}
+}
@@ -1,13 +1,17 @@
 package vimeo
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -20,15 +24,18 @@ type VideosService struct {
 	urlPrefix string
 }
 
-
 type dataListVideo struct {
 	Data []*Video `json:"data,omitempty"`
 	pagination
 }
 
-// Embed internal object provides access to HTML embed code.
+// Embed internal object provides access to HTML embed code and to the
+// player settings.
 type Embed struct {
-	HTML string `json:"html,omitempty"`
+	HTML    string   `json:"html,omitempty"`
+	Buttons *Buttons `json:"buttons,omitempty"`
+	Logos   *Logos   `json:"logos,omitempty"`
+	Color   string   `json:"color,omitempty"`
 }
 
 // Stats internal object provides access to video statistic.
@@ -92,31 +99,88 @@ type EmbedPresets struct {
 	User     *User          `json:"user,omitempty"`
 }
 
+// VideoInteraction internal object provides access to an interaction of
+// the authenticated user with video.
+type VideoInteraction struct {
+	URI       string    `json:"uri,omitempty"`
+	Added     bool      `json:"added"`
+	AddedTime Timestamp `json:"added_time,omitempty"`
+}
+
+// VideoInteractions internal object provides access to the interactions of
+// the authenticated user with video.
+type VideoInteractions struct {
+	WatchLater *VideoInteraction `json:"watchlater,omitempty"`
+}
+
+// VideoMetadata internal object provides access to video metadata.
+type VideoMetadata struct {
+	Connections  map[string]*Connection `json:"connections,omitempty"`
+	Interactions *VideoInteractions     `json:"interactions,omitempty"`
+}
+
 // Video represents a video.
 type Video struct {
-	URI           string        `json:"uri,omitempty"`
-	Name          string        `json:"name,omitempty"`
-	Description   string        `json:"description,omitempty"`
-	Link          string        `json:"link,omitempty"`
-	Duration      int           `json:"duration,omitempty"`
-	Width         int           `json:"width,omitempty"`
-	Height        int           `json:"height,omitempty"`
-	Language      string        `json:"language,omitempty"`
-	Embed         *Embed        `json:"embed,omitempty"`
-	CreatedTime   time.Time     `json:"created_time,omitempty"`
-	ModifiedTime  time.Time     `json:"modified_time,omitempty"`
-	ReleaseTime   time.Time     `json:"release_time,omitempty"`
-	ContentRating []string      `json:"content_rating,omitempty"`
-	License       string        `json:"license,omitempty"`
-	Privacy       *Privacy      `json:"privacy,omitempty"`
-	Pictures      *Pictures     `json:"pictures,omitempty"`
-	Tags          []*Tag        `json:"tags,omitempty"`
-	Stats         *Stats        `json:"stats,omitempty"`
-	User          *User         `json:"user,omitempty"`
-	App           *App          `json:"app,omitempty"`
-	Status        string        `json:"status,omitempty"`
-	ResourceKey   string        `json:"resource_key,omitempty"`
-	EmbedPresets  *EmbedPresets `json:"embed_presets,omitempty"`
+	URI           string          `json:"uri,omitempty"`
+	Name          string          `json:"name,omitempty"`
+	Description   string          `json:"description,omitempty"`
+	Link          string          `json:"link,omitempty"`
+	Duration      int             `json:"duration,omitempty"`
+	Width         int             `json:"width,omitempty"`
+	Height        int             `json:"height,omitempty"`
+	Language      string          `json:"language,omitempty"`
+	Embed         *Embed          `json:"embed,omitempty"`
+	CreatedTime   Timestamp       `json:"created_time,omitempty"`
+	ModifiedTime  Timestamp       `json:"modified_time,omitempty"`
+	ReleaseTime   Timestamp       `json:"release_time,omitempty"`
+	ContentRating []string        `json:"content_rating,omitempty"`
+	License       string          `json:"license,omitempty"`
+	Privacy       *Privacy        `json:"privacy,omitempty"`
+	Pictures      *Pictures       `json:"pictures,omitempty"`
+	Tags          []*Tag          `json:"tags,omitempty"`
+	Stats         *Stats          `json:"stats,omitempty"`
+	User          *User           `json:"user,omitempty"`
+	App           *App            `json:"app,omitempty"`
+	Status        string          `json:"status,omitempty"`
+	ResourceKey   string          `json:"resource_key,omitempty"`
+	EmbedPresets  *EmbedPresets   `json:"embed_presets,omitempty"`
+	ParentFolder  *Project        `json:"parent_folder,omitempty"`
+	Metadata      *VideoMetadata  `json:"metadata,omitempty"`
+	Upload        *VideoUpload    `json:"upload,omitempty"`
+	Transcode     *VideoTranscode `json:"transcode,omitempty"`
+	Download      []*Download     `json:"download,omitempty"`
+	Spatial       *Spatial        `json:"spatial,omitempty"`
+}
+
+// Spatial internal object provides access to the 360 metadata of a video.
+type Spatial struct {
+	// Projection is one of "equirectangular", "cubical", "cylindrical" or
+	// "pyramid".
+	Projection string `json:"projection,omitempty"`
+	// StereoFormat is one of "mono", "left-right" or "top-bottom".
+	StereoFormat string `json:"stereo_format,omitempty"`
+	FieldOfView  int    `json:"field_of_view,omitempty"`
+}
+
+var spatialProjections = map[string]bool{
+	"equirectangular": true,
+	"cubical":         true,
+	"cylindrical":     true,
+	"pyramid":         true,
+}
+
+// VideoTranscode internal object provides access to the transcode state of
+// a video. Status is one of "complete", "error" or "in_progress".
+type VideoTranscode struct {
+	Status string `json:"status,omitempty"`
+}
+
+// VideoUpload internal object provides access to the upload state of a video.
+type VideoUpload struct {
+	Status     string `json:"status,omitempty"`
+	Approach   string `json:"approach,omitempty"`
+	UploadLink string `json:"upload_link,omitempty"`
+	Size       int64  `json:"size,omitempty"`
 }
 
 // UploadVideo represents a video.
@@ -187,17 +251,173 @@ type EmbedRequest struct {
 	OverlayEmailCaptureConfirmation string             `json:"overlay_email_capture_confirmation,omitempty"`
 }
 
+// PrivacyRequest represents the privacy settings to change in a request.
+// The settings left empty or nil are kept as is.
+type PrivacyRequest struct {
+	View     string `json:"view,omitempty"`
+	Embed    string `json:"embed,omitempty"`
+	Comments string `json:"comments,omitempty"`
+	Download *bool  `json:"download,omitempty"`
+	Add      *bool  `json:"add,omitempty"`
+}
+
 // VideoRequest represents a request to edit an video.
 type VideoRequest struct {
-	Name          string        `json:"name,omitempty"`
-	Description   string        `json:"description,omitempty"`
-	License       string        `json:"license,omitempty"`
-	Privacy       *Privacy      `json:"privacy,omitempty"`
-	Password      string        `json:"password,omitempty"`
-	ReviewLink    bool          `json:"review_link"`
-	Locale        string        `json:"locale,omitempty"`
-	ContentRating []string      `json:"content_rating,omitempty"`
-	Embed         *EmbedRequest `json:"embed,omitempty"`
+	Name          string          `json:"name,omitempty"`
+	Description   string          `json:"description,omitempty"`
+	License       string          `json:"license,omitempty"`
+	Privacy       *PrivacyRequest `json:"privacy,omitempty"`
+	Password      string          `json:"password,omitempty"`
+	ReviewLink    bool            `json:"review_link"`
+	Locale        string          `json:"locale,omitempty"`
+	ContentRating []string        `json:"content_rating,omitempty"`
+	Embed         *EmbedRequest   `json:"embed,omitempty"`
+	Spatial       *Spatial        `json:"spatial,omitempty"`
+	// HideFromVimeo hides the video from Vimeo, it can only be embedded.
+	// It sets the view privacy to "disable".
+	HideFromVimeo bool `json:"-"`
+}
+
+// privacyViewHidden is the view privacy of the videos hidden from Vimeo.
+const privacyViewHidden = "disable"
+
+func (r *VideoRequest) validate() error {
+	if r == nil {
+		return nil
+	}
+
+	if r.Spatial != nil && r.Spatial.Projection != "" && !spatialProjections[r.Spatial.Projection] {
+		return fmt.Errorf("invalid spatial projection %q", r.Spatial.Projection)
+	}
+
+	if !r.HideFromVimeo {
+		return nil
+	}
+
+	if r.Privacy != nil && r.Privacy.View != "" && r.Privacy.View != privacyViewHidden {
+		return fmt.Errorf("the video can't be hidden from Vimeo with view privacy %q", r.Privacy.View)
+	}
+
+	if r.Password != "" {
+		return errors.New("the video can't be hidden from Vimeo with a password")
+	}
+
+	return nil
+}
+
+// body returns the request with HideFromVimeo applied to the privacy.
+func (r *VideoRequest) body() *VideoRequest {
+	if r == nil || !r.HideFromVimeo {
+		return r
+	}
+
+	body := *r
+	privacy := PrivacyRequest{}
+	if r.Privacy != nil {
+		privacy = *r.Privacy
+	}
+	privacy.View = privacyViewHidden
+	body.Privacy = &privacy
+
+	return &body
+}
+
+// forbidden reports a clear error when the API rejected hiding the video
+// from Vimeo, which basic accounts can't do.
+func (r *VideoRequest) forbidden(resp *Response, err error) error {
+	b := r.body()
+	if b != nil && b.Privacy != nil && b.Privacy.View == privacyViewHidden && resp != nil && resp.StatusCode == http.StatusForbidden {
+		return fmt.Errorf("hiding the video from Vimeo requires a paid account: %w", err)
+	}
+
+	return err
+}
+
+// transcoded reports whether the video is transcoded, or an error if the
+// upload or the transcoding failed. The video status is used when the
+// transcode state is missing.
+func (v *Video) transcoded() (bool, error) {
+	if v.Upload != nil && v.Upload.Status == "error" {
+		return false, errors.New("the video upload failed")
+	}
+
+	if v.Transcode != nil && v.Transcode.Status != "" {
+		switch v.Transcode.Status {
+		case "complete":
+			return true, nil
+		case "error":
+			return false, errors.New("the video transcoding failed")
+		}
+		return false, nil
+	}
+
+	switch {
+	case v.Status == "available":
+		return true, nil
+	case strings.HasSuffix(v.Status, "error"):
+		return false, errors.New("the video transcoding failed: " + v.Status)
+	}
+	return false, nil
+}
+
+// waitTranscode gets the video every interval, transcodePollInterval if not
+// positive, until it's transcoded.
+func waitTranscode(ctx context.Context, c *Client, uri string, interval time.Duration) (*Video, error) {
+	if interval <= 0 {
+		interval = transcodePollInterval
+	}
+
+	for {
+		v, _, err := getVideo(ctx, c, uri)
+		if err != nil {
+			return nil, err
+		}
+
+		done, err := v.transcoded()
+		if err != nil {
+			return v, err
+		}
+		if done {
+			return v, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return v, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// EmbedCode returns the <iframe> HTML embedding the video, requesting only
+// the embed.html field. An error is returned if the privacy of the video
+// doesn't allow embedding it.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D
+func (s *VideosService) EmbedCode(ctx context.Context, vid int) (string, *Response, error) {
+	u := s.url("%d", vid)
+	video, resp, err := getVideo(WithFields(ctx, "embed.html"), s.client, u)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusForbidden {
+			return "", resp, fmt.Errorf("video %d can't be embedded: %w", vid, err)
+		}
+		return "", resp, err
+	}
+
+	if video.Embed == nil || video.Embed.HTML == "" {
+		return "", resp, fmt.Errorf("video %d has no embed code", vid)
+	}
+
+	return video.Embed.HTML, resp, nil
+}
+
+// WaitForTranscode gets the video every interval, 5 seconds if not positive,
+// until it's transcoded and returns it. An error is returned if the upload or
+// the transcoding failed, or if ctx is done first.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D
+func (s *VideosService) WaitForTranscode(ctx context.Context, vid int, interval time.Duration) (*Video, error) {
+	return waitTranscode(ctx, s.client, s.url("%d", vid), interval)
 }
 
 // GetID returns the numeric identifier (ID) of the video.
@@ -207,18 +427,64 @@ func (v Video) GetID() int {
 	return ID
 }
 
+// InWatchLater reports whether the authenticated user added the video
+// to the watch later list.
+func (v Video) InWatchLater() bool {
+	if v.Metadata == nil || v.Metadata.Interactions == nil || v.Metadata.Interactions.WatchLater == nil {
+		return false
+	}
+	return v.Metadata.Interactions.WatchLater.Added
+}
+
+// HiddenFromVimeo reports whether the video is hidden from Vimeo and can
+// only be embedded.
+func (v Video) HiddenFromVimeo() bool {
+	return v.Privacy != nil && v.Privacy.View == privacyViewHidden
+}
+
+// Embeddable reports whether the video can be embedded on any site.
+// Videos whose embedding is restricted to whitelisted domains are not
+// reported as embeddable.
+func (v Video) Embeddable() bool {
+	return v.Privacy != nil && v.Privacy.Embed == "public"
+}
+
 // ListVideoOptions specifies the optional parameters to the
 // CategoriesService.ListVideo method.
 type ListVideoOptions struct {
 	Query            string `url:"query,omitempty"`
 	Filter           string `url:"filter,omitempty"`
-	FilterEmbeddable string `url:"filter_embeddable,omitempty"`
-	Sort             string `url:"sort,omitempty"`
-	Direction        string `url:"direction,omitempty"`
-	FilterPlayable   string `url:"filter_playable,omitempty"`
+	FilterEmbeddable *bool  `url:"filter_embeddable,omitempty"`
+	// Sort is such as "date", "alphabetical", "plays", "likes", "duration",
+	// "modified_time" or "default", the values accepted depend on the list,
+	// e.g. "added" for the videos of a channel. Direction is "asc" or "desc".
+	Sort           string `url:"sort,omitempty"`
+	Direction      string `url:"direction,omitempty"`
+	FilterPlayable *bool  `url:"filter_playable,omitempty"`
+	Privacy        string `url:"privacy,omitempty"`
+	// ContainingURI returns the page that contains the resource with this
+	// URI, e.g. "/videos/123".
+	ContainingURI string `url:"containing_uri,omitempty"`
+	// FilterContentRating keeps only the videos with these content ratings,
+	// such as "safe" or "unrated", used with the "content_rating" filter.
+	FilterContentRating []string `url:"filter_content_rating,comma,omitempty"`
 	ListOptions
 }
 
+// validate checks Direction only, the API rejects the sorts not accepted
+// by the list.
+func (o *ListVideoOptions) validate() error {
+	if o == nil {
+		return nil
+	}
+
+	if o.Direction != "" && o.Direction != "asc" && o.Direction != "desc" {
+		return fmt.Errorf("invalid direction %q, must be \"asc\" or \"desc\"", o.Direction)
+	}
+
+	return nil
+}
+
 // UploadVideoOptions specifies the optional parameters to the
 // uploadVideo method.
 type UploadVideoOptions struct {
@@ -226,7 +492,55 @@ type UploadVideoOptions struct {
 	Link string `json:"link,omitempty"`
 }
 
-func listVideo(c *Client, url string, opt *ListVideoOptions) ([]*Video, *Response, error) {
+// SearchVideoOptions specifies the optional parameters to the
+// VideosService.Search method.
+type SearchVideoOptions struct {
+	Query string `url:"query,omitempty"`
+	// Filter is one of "CC", "CC-BY", "CC-BY-NC", "CC-BY-NC-ND",
+	// "CC-BY-NC-SA", "CC-BY-ND", "CC-BY-SA", "CC0", "categories",
+	// "content_rating", "duration", "in-progress", "minimum_likes",
+	// "trending" or "upload_date".
+	Filter string `url:"filter,omitempty"`
+	// FilterUploadDate is one of "day", "week", "month" or "year", used
+	// with the "upload_date" filter.
+	FilterUploadDate string `url:"filter_upload_date,omitempty"`
+	FilterEmbeddable *bool  `url:"filter_embeddable,omitempty"`
+	FilterPlayable   *bool  `url:"filter_playable,omitempty"`
+	// FilterContentRating keeps only the videos with these content ratings,
+	// used with the "content_rating" filter.
+	FilterContentRating []string `url:"filter_content_rating,comma,omitempty"`
+	// Sort is one of "relevant", "date", "alphabetical", "plays", "likes",
+	// "comments" or "duration".
+	Sort      string `url:"sort,omitempty"`
+	Direction string `url:"direction,omitempty"`
+	ListOptions
+}
+
+func (o *SearchVideoOptions) validate() error {
+	if o == nil {
+		return nil
+	}
+
+	switch o.Sort {
+	case "", "relevant", "date", "alphabetical", "plays", "likes", "comments", "duration":
+	default:
+		return fmt.Errorf("invalid sort %q", o.Sort)
+	}
+
+	if o.Direction != "" && o.Direction != "asc" && o.Direction != "desc" {
+		return fmt.Errorf("invalid direction %q, must be \"asc\" or \"desc\"", o.Direction)
+	}
+
+	return nil
+}
+
+func listVideo(ctx context.Context, c *Client, url string, opt interface{}) ([]*Video, *Response, error) {
+	if o, ok := opt.(*ListVideoOptions); ok {
+		if err := o.validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	u, err := addOptions(url, opt)
 	if err != nil {
 		return nil, nil, err
@@ -239,7 +553,7 @@ func listVideo(c *Client, url string, opt *ListVideoOptions) ([]*Video, *Respons
 
 	videos := &dataListVideo{}
 
-	resp, err := c.Do(req, videos)
+	resp, err := c.Do(ctx, req, videos)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -249,7 +563,7 @@ func listVideo(c *Client, url string, opt *ListVideoOptions) ([]*Video, *Respons
 	return videos.Data, resp, err
 }
 
-func getVideo(c *Client, url string) (*Video, *Response, error) {
+func getVideo(ctx context.Context, c *Client, url string) (*Video, *Response, error) {
 	req, err := c.NewRequest("GET", url, nil)
 	if err != nil {
 		return nil, nil, err
@@ -257,7 +571,7 @@ func getVideo(c *Client, url string) (*Video, *Response, error) {
 
 	video := &Video{}
 
-	resp, err := c.Do(req, video)
+	resp, err := c.Do(ctx, req, video)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -265,7 +579,7 @@ func getVideo(c *Client, url string) (*Video, *Response, error) {
 	return video, resp, err
 }
 
-func getUploadVideo(c *Client, uri string, opt *UploadVideoOptions) (*UploadVideo, *Response, error) {
+func getUploadVideo(ctx context.Context, c *Client, uri string, opt *UploadVideoOptions) (*UploadVideo, *Response, error) {
 	req, err := c.NewRequest("POST", uri, opt)
 	if err != nil {
 		return nil, nil, err
@@ -273,7 +587,7 @@ func getUploadVideo(c *Client, uri string, opt *UploadVideoOptions) (*UploadVide
 
 	uploadVideo := &UploadVideo{}
 
-	resp, err := c.Do(req, uploadVideo)
+	resp, err := c.Do(ctx, req, uploadVideo)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -281,14 +595,14 @@ func getUploadVideo(c *Client, uri string, opt *UploadVideoOptions) (*UploadVide
 	return uploadVideo, resp, err
 }
 
-func completeUploadVideo(c *Client, completeURI string) (*Video, *Response, error) {
+func completeUploadVideo(ctx context.Context, c *Client, completeURI string) (*Video, *Response, error) {
 	req, err := c.NewRequest("DELETE", completeURI, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	// Get uri form header location
-	resp, err := c.Do(req, nil)
+	resp, err := c.Do(ctx, req, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -298,12 +612,12 @@ func completeUploadVideo(c *Client, completeURI string) (*Video, *Response, erro
 		return nil, nil, err
 	}
 
-	video, resp, err := getVideo(c, url.String())
+	video, resp, err := getVideo(ctx, c, url.String())
 
 	return video, resp, err
 }
 
-func processUploadVideo(c *Client, uploadURL string) (int64, error) {
+func processUploadVideo(ctx context.Context, c *Client, uploadURL string) (int64, error) {
 	req, err := http.NewRequest("PUT", uploadURL, nil)
 	if err != nil {
 		return int64(0), err
@@ -311,7 +625,7 @@ func processUploadVideo(c *Client, uploadURL string) (int64, error) {
 	req.Header.Set("Content-Length", "0")
 	req.Header.Set("Content-Range", "bytes */*")
 
-	resp, err := c.Do(req, nil)
+	resp, err := c.Do(ctx, req, nil)
 	if err != nil {
 		return int64(0), err
 	}
@@ -326,9 +640,7 @@ func processUploadVideo(c *Client, uploadURL string) (int64, error) {
 	return int64(lastByte), nil
 }
 
-func uploadVideo(c *Client, url string, file *os.File) (*Video, *Response, error) {
-	opt := &UploadVideoOptions{Type: "streaming"}
-
+func uploadVideo(ctx context.Context, c *Client, url string, file *os.File) (*Video, *Response, error) {
 	stat, err := file.Stat()
 	if err != nil {
 		return nil, nil, err
@@ -338,24 +650,36 @@ func uploadVideo(c *Client, url string, file *os.File) (*Video, *Response, error
 		return nil, nil, errors.New("the video file can't be a directory")
 	}
 
-	uploadVideo, _, err := getUploadVideo(c, url, opt)
+	return uploadVideoReader(ctx, c, url, file, stat.Size())
+}
+
+// legacyUploadAPIVersion is the version of the API the "streaming" and
+// "pull" upload types are sent with. The later versions only know the
+// upload approach of UploadService and answer with other response shapes.
+const legacyUploadAPIVersion = "3.2"
+
+func uploadVideoReader(ctx context.Context, c *Client, url string, r io.Reader, size int64) (*Video, *Response, error) {
+	ctx = WithAPIVersion(ctx, legacyUploadAPIVersion)
+	opt := &UploadVideoOptions{Type: "streaming"}
+
+	uploadVideo, _, err := getUploadVideo(ctx, c, url, opt)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	lastByte := int64(0)
-	for lastByte < stat.Size() {
-		req, err := c.NewUploadRequest(uploadVideo.UploadLinkSecure, file, stat.Size(), lastByte)
+	for lastByte < size {
+		req, err := c.NewUploadRequest(uploadVideo.UploadLinkSecure, r, size, lastByte)
 		if err != nil {
 			return nil, nil, err
 		}
 
-		_, err = c.Do(req, nil)
+		_, err = c.Do(ctx, req, nil)
 		if err != nil {
 			switch nerr := err.(type) {
 			case net.Error:
 				if nerr.Timeout() {
-					lastByte, err = processUploadVideo(c, uploadVideo.UploadLinkSecure)
+					lastByte, err = processUploadVideo(ctx, c, uploadVideo.UploadLinkSecure)
 					if err != nil {
 						return nil, nil, err
 					}
@@ -365,18 +689,19 @@ func uploadVideo(c *Client, url string, file *os.File) (*Video, *Response, error
 				return nil, nil, err
 			}
 		}
-		lastByte, err = processUploadVideo(c, uploadVideo.UploadLinkSecure)
+		lastByte, err = processUploadVideo(ctx, c, uploadVideo.UploadLinkSecure)
 		if err != nil {
 			return nil, nil, err
 		}
 	}
 
-	video, resp, err := completeUploadVideo(c, uploadVideo.CompleteURI)
+	video, resp, err := completeUploadVideo(ctx, c, uploadVideo.CompleteURI)
 
 	return video, resp, err
 }
 
-func uploadVideoByURL(c *Client, uri, videoURL string) (*Video, *Response, error) {
+func uploadVideoByURL(ctx context.Context, c *Client, uri, videoURL string) (*Video, *Response, error) {
+	ctx = WithAPIVersion(ctx, legacyUploadAPIVersion)
 	opt := &UploadVideoOptions{Type: "pull", Link: videoURL}
 	req, err := c.NewRequest("POST", uri, opt)
 	if err != nil {
@@ -385,7 +710,7 @@ func uploadVideoByURL(c *Client, uri, videoURL string) (*Video, *Response, error
 
 	video := &Video{}
 
-	resp, err := c.Do(req, video)
+	resp, err := c.Do(ctx, req, video)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -393,39 +718,113 @@ func uploadVideoByURL(c *Client, uri, videoURL string) (*Video, *Response, error
 	return video, resp, err
 }
 
-func deleteVideo(c *Client, url string) (*Response, error) {
+func deleteVideo(ctx context.Context, c *Client, url string) (*Response, error) {
 	req, err := c.NewRequest("DELETE", url, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return c.Do(req, nil)
+	return c.Do(ctx, req, nil)
 }
 
-func addVideo(c *Client, url string) (*Response, error) {
+func addVideo(ctx context.Context, c *Client, url string) (*Response, error) {
 	req, err := c.NewRequest("PUT", url, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return c.Do(req, nil)
+	return c.Do(ctx, req, nil)
 }
 
 // List lists the videos.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos
-func (s *VideosService) List(opt *ListVideoOptions) ([]*Video, *Response, error) {
-	videos, resp, err := listVideo(s.client, s.url(""), opt)
+func (s *VideosService) List(ctx context.Context, opt *ListVideoOptions) ([]*Video, *Response, error) {
+	videos, resp, err := listVideo(ctx, s.client, s.url(""), opt)
+
+	return videos, resp, err
+}
+
+// Search searches all the videos of Vimeo.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/videos
+func (s *VideosService) Search(ctx context.Context, opt *SearchVideoOptions) ([]*Video, *Response, error) {
+	if err := opt.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	videos, resp, err := listVideo(ctx, s.client, "videos", opt)
 
 	return videos, resp, err
 }
 
+// ErrRelatedUnavailable is matched by the error of ListRelated when the plan
+// of the token doesn't give access to the related videos.
+var ErrRelatedUnavailable = errors.New("vimeo: related videos unavailable")
+
+// relatedError is the error of ListRelated on 403 Forbidden. It matches
+// ErrRelatedUnavailable and wraps the ErrorResponse.
+type relatedError struct {
+	vid int
+	err error
+}
+
+func (e *relatedError) Error() string {
+	return fmt.Sprintf("related videos of video %d aren't available: %v", e.vid, e.err)
+}
+
+func (e *relatedError) Unwrap() error { return e.err }
+
+func (e *relatedError) Is(target error) bool { return target == ErrRelatedUnavailable }
+
+// ListRelated lists the videos related to the video, with the "related"
+// filter. An error is returned if opt has another Filter. The error of a
+// token whose plan doesn't give access to the related videos matches
+// ErrRelatedUnavailable and ErrForbidden.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/videos
+func (s *VideosService) ListRelated(ctx context.Context, vid int, opt *ListVideoOptions) ([]*Video, *Response, error) {
+	o := ListVideoOptions{}
+	if opt != nil {
+		o = *opt
+	}
+	if o.Filter != "" && o.Filter != "related" {
+		return nil, nil, fmt.Errorf("invalid related videos filter %q, must be \"related\"", o.Filter)
+	}
+	o.Filter = "related"
+
+	videos, resp, err := listVideo(ctx, s.client, s.url("%d/videos", vid), &o)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusForbidden {
+			return nil, resp, &relatedError{vid: vid, err: err}
+		}
+		return nil, resp, err
+	}
+
+	return videos, resp, nil
+}
+
 // Get specific video by ID.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D
-func (s *VideosService) Get(vid int) (*Video, *Response, error) {
+func (s *VideosService) Get(ctx context.Context, vid int) (*Video, *Response, error) {
 	u := s.url("%d", vid)
-	video, resp, err := getVideo(s.client, u)
+	video, resp, err := getVideo(ctx, s.client, u)
+
+	return video, resp, err
+}
+
+// GetByURI returns the video at the URI returned by the API, such as
+// Feed.Clip.URI.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D
+func (s *VideosService) GetByURI(ctx context.Context, uri string) (*Video, *Response, error) {
+	video := &Video{}
+
+	resp, err := s.client.GetByURI(ctx, uri, video)
+	if err != nil {
+		return nil, resp, err
+	}
 
 	return video, resp, err
 }
@@ -433,17 +832,21 @@ func (s *VideosService) Get(vid int) (*Video, *Response, error) {
 // Edit specific video by ID.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D
-func (s *VideosService) Edit(vid int, r *VideoRequest) (*Video, *Response, error) {
+func (s *VideosService) Edit(ctx context.Context, vid int, r *VideoRequest) (*Video, *Response, error) {
+	if err := r.validate(); err != nil {
+		return nil, nil, err
+	}
+
 	u := s.url("%d", vid)
-	req, err := s.client.NewRequest("PATCH", u, r)
+	req, err := s.client.NewRequest("PATCH", u, r.body())
 	if err != nil {
 		return nil, nil, err
 	}
 
 	video := &Video{}
-	resp, err := s.client.Do(req, video)
+	resp, err := s.client.Do(ctx, req, video)
 	if err != nil {
-		return nil, resp, err
+		return nil, resp, r.forbidden(resp, err)
 	}
 
 	return video, resp, nil
@@ -452,9 +855,9 @@ func (s *VideosService) Edit(vid int, r *VideoRequest) (*Video, *Response, error
 // Delete specific video by ID.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D
-func (s *VideosService) Delete(vid int) (*Response, error) {
+func (s *VideosService) Delete(ctx context.Context, vid int) (*Response, error) {
 	u := s.url("%d", vid)
-	resp, err := deleteVideo(s.client, u)
+	resp, err := deleteVideo(ctx, s.client, u)
 
 	return resp, err
 }
@@ -462,9 +865,9 @@ func (s *VideosService) Delete(vid int) (*Response, error) {
 // ListCategory lists the video category.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/categories
-func (s *VideosService) ListCategory(vid int, opt *ListCategoryOptions) ([]*Category, *Response, error) {
+func (s *VideosService) ListCategory(ctx context.Context, vid int, opt *ListCategoryOptions) ([]*Category, *Response, error) {
 	u := s.url("%d/categories", vid)
-	catogories, resp, err := listCategory(s.client, u, opt)
+	catogories, resp, err := listCategory(ctx, s.client, u, opt)
 
 	return catogories, resp, err
 }
@@ -472,17 +875,59 @@ func (s *VideosService) ListCategory(vid int, opt *ListCategoryOptions) ([]*Cate
 // LikeList lists users who liked this video.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/likes
-func (s *VideosService) LikeList(vid int, opt *ListUserOptions) ([]*User, *Response, error) {
+func (s *VideosService) LikeList(ctx context.Context, vid int, opt *ListUserOptions) ([]*User, *Response, error) {
 	u := s.url("%d/likes", vid)
-	users, resp, err := listUser(s.client, u, opt)
+	users, resp, err := listUser(ctx, s.client, u, opt)
 
 	return users, resp, err
 }
 
+// Engagement represents the engagement summary of a video.
+type Engagement struct {
+	Likes        int
+	Comments     int
+	RecentLikers []*User
+}
+
+// Engagement returns the number of likes and comments of the video and up to
+// sample most recent likers.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/likes
+func (s *VideosService) Engagement(ctx context.Context, vid int, sample int) (*Engagement, error) {
+	e := &Engagement{}
+
+	if sample > 0 {
+		opt := &ListUserOptions{ListOptions: ListOptions{PerPage: sample}}
+		users, resp, err := s.LikeList(ctx, vid, opt)
+		if err != nil {
+			return nil, err
+		}
+
+		e.Likes = resp.Total
+		e.RecentLikers = users
+	} else {
+		likes, _, err := countList(ctx, s.client, s.url("%d/likes", vid))
+		if err != nil {
+			return nil, err
+		}
+
+		e.Likes = likes
+	}
+
+	comments, _, err := countList(ctx, s.client, s.url("%d/comments", vid))
+	if err != nil {
+		return nil, err
+	}
+
+	e.Comments = comments
+
+	return e, nil
+}
+
 // GetPreset get preset by name.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/presets/%7Bpreset_id%7D
-func (s *VideosService) GetPreset(vid int, p int) (*Preset, *Response, error) {
+func (s *VideosService) GetPreset(ctx context.Context, vid int, p int) (*Preset, *Response, error) {
 	u := s.url("%d/presets/%d", vid, p)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -491,7 +936,7 @@ func (s *VideosService) GetPreset(vid int, p int) (*Preset, *Response, error) {
 
 	portf := &Preset{}
 
-	resp, err := s.client.Do(req, portf)
+	resp, err := s.client.Do(ctx, req, portf)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -502,27 +947,27 @@ func (s *VideosService) GetPreset(vid int, p int) (*Preset, *Response, error) {
 // AssignPreset embed preset by name.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/presets/%7Bpreset_id%7D
-func (s *VideosService) AssignPreset(vid int, p int) (*Response, error) {
+func (s *VideosService) AssignPreset(ctx context.Context, vid int, p int) (*Response, error) {
 	u := s.url("%d/presets/%d", vid, p)
 	req, err := s.client.NewRequest("PUT", u, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
 
 // UnassignPreset embed preset by name.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/presets/%7Bpreset_id%7D
-func (s *VideosService) UnassignPreset(vid int, p int) (*Response, error) {
+func (s *VideosService) UnassignPreset(ctx context.Context, vid int, p int) (*Response, error) {
 	u := s.url("%d/presets/%d", vid, p)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
 
 type dataListDomain struct {
@@ -532,14 +977,16 @@ type dataListDomain struct {
 
 // Domain represents a domain.
 type Domain struct {
-	URI  string `json:"uri,omitempty"`
-	Name string `json:"name,omitempty"`
+	URI     string `json:"uri,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Domain  string `json:"domain,omitempty"`
+	AllowHD bool   `json:"allow_hd"`
 }
 
 // ListDomain lists the domains.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/privacy/domains
-func (s *VideosService) ListDomain(vid int) ([]*Domain, *Response, error) {
+func (s *VideosService) ListDomain(ctx context.Context, vid int) ([]*Domain, *Response, error) {
 	u := s.url("%d/privacy/domains", vid)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -548,7 +995,7 @@ func (s *VideosService) ListDomain(vid int) ([]*Domain, *Response, error) {
 
 	domains := &dataListDomain{}
 
-	resp, err := s.client.Do(req, domains)
+	resp, err := s.client.Do(ctx, req, domains)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -561,35 +1008,196 @@ func (s *VideosService) ListDomain(vid int) ([]*Domain, *Response, error) {
 // AllowDomain embedding on a domain.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/privacy/domains/%7Bdomain%7D
-func (s *VideosService) AllowDomain(vid int, d string) (*Response, error) {
+func (s *VideosService) AllowDomain(ctx context.Context, vid int, d string) (*Response, error) {
 	u := s.url("%d/privacy/domains/%s", vid, d)
 	req, err := s.client.NewRequest("PUT", u, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
 
 // DisallowDomain embedding on a domain.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/privacy/domains/%7Bdomain%7D
-func (s *VideosService) DisallowDomain(vid int, d string) (*Response, error) {
+func (s *VideosService) DisallowDomain(ctx context.Context, vid int, d string) (*Response, error) {
 	u := s.url("%d/privacy/domains/%s", vid, d)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListEmbedDomains lists the names of the domains the video can be embedded
+// on, across all pages.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/privacy/domains
+func (s *VideosService) ListEmbedDomains(ctx context.Context, vid int) ([]string, *Response, error) {
+	var names []string
+
+	u := s.url("%d/privacy/domains", vid)
+	for {
+		req, err := s.client.NewRequest("GET", u, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		domains := &dataListDomain{}
+
+		resp, err := s.client.Do(ctx, req, domains)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		resp.setPaging(domains)
+
+		for _, d := range domains.Data {
+			names = append(names, d.Domain)
+		}
+
+		if resp.NextPage == "" {
+			return names, resp, nil
+		}
+		u = pagePath(resp.NextPage)
+	}
+}
+
+// SetEmbedDomains makes domains the exact set of domains the video can be
+// embedded on, allowing the missing ones and disallowing the others. The
+// embed privacy of the video must be "whitelist", else an error is returned
+// without any change.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/privacy/domains/%7Bdomain%7D
+func (s *VideosService) SetEmbedDomains(ctx context.Context, vid int, domains []string) (*Response, error) {
+	video, resp, err := s.Get(WithFields(ctx, "privacy.embed"), vid)
+	if err != nil {
+		return resp, err
+	}
+
+	if video.Privacy == nil || video.Privacy.Embed != "whitelist" {
+		var embed string
+		if video.Privacy != nil {
+			embed = video.Privacy.Embed
+		}
+		return resp, fmt.Errorf("the embed privacy of video %d is %q, the domains only apply to \"whitelist\"", vid, embed)
+	}
+
+	current, resp, err := s.ListEmbedDomains(ctx, vid)
+	if err != nil {
+		return resp, err
+	}
+
+	want := make(map[string]bool, len(domains))
+	for _, d := range domains {
+		want[d] = true
+	}
+
+	for _, d := range current {
+		if want[d] {
+			delete(want, d)
+			continue
+		}
+		resp, err = s.DisallowDomain(ctx, vid, d)
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	// Keep the order of domains in the calls.
+	for _, d := range domains {
+		if !want[d] {
+			continue
+		}
+		delete(want, d)
+		resp, err = s.AllowDomain(ctx, vid, d)
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
+
+// RemoveEmbedDomains disallows embedding the video on each of the domains.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/privacy/domains/%7Bdomain%7D
+func (s *VideosService) RemoveEmbedDomains(ctx context.Context, vid int, domains []string) (*Response, error) {
+	var resp *Response
+	for _, d := range domains {
+		var err error
+		resp, err = s.DisallowDomain(ctx, vid, d)
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
+
+// IsEmbeddableOn reports whether the video can be embedded on the domain,
+// according to the embed privacy of the video and its domain whitelist,
+// across all pages. Whitelist entries starting with "*." match any subdomain.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/privacy/domains
+func (s *VideosService) IsEmbeddableOn(ctx context.Context, vid int, domain string) (bool, error) {
+	video, _, err := s.Get(WithFields(ctx, "privacy.embed"), vid)
+	if err != nil {
+		return false, err
+	}
+
+	if video.Privacy == nil {
+		return false, nil
+	}
+
+	switch video.Privacy.Embed {
+	case "public":
+		return true, nil
+	case "whitelist":
+	default:
+		return false, nil
+	}
+
+	domains, _, err := s.ListEmbedDomains(ctx, vid)
+	if err != nil {
+		return false, err
+	}
+
+	host := normalizeDomain(domain)
+	for _, d := range domains {
+		allowed := normalizeDomain(d)
+
+		if allowed == host {
+			return true, nil
+		}
+		if strings.HasPrefix(allowed, "*.") && strings.HasSuffix(host, allowed[1:]) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// normalizeDomain returns the lower case host of a domain or URL.
+func normalizeDomain(d string) string {
+	d = strings.ToLower(strings.TrimSpace(d))
+	if i := strings.Index(d, "://"); i >= 0 {
+		d = d[i+3:]
+	}
+	if i := strings.IndexAny(d, "/:"); i >= 0 {
+		d = d[:i]
+	}
+	return d
 }
 
 // ListUser list the all allowed users
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/privacy/users
-func (s *VideosService) ListUser(vid int) ([]*User, *Response, error) {
+func (s *VideosService) ListUser(ctx context.Context, vid int) ([]*User, *Response, error) {
 	u := s.url("%d/privacy/users", vid)
-	users, resp, err := listUser(s.client, u, nil)
+	users, resp, err := listUser(ctx, s.client, u, nil)
 
 	return users, resp, err
 }
@@ -597,48 +1205,56 @@ func (s *VideosService) ListUser(vid int) ([]*User, *Response, error) {
 // AllowUsers allow users to view this video.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/privacy/users
-func (s *VideosService) AllowUsers(vid int) (*Response, error) {
+func (s *VideosService) AllowUsers(ctx context.Context, vid int) (*Response, error) {
 	u := s.url("%d/privacy/users", vid)
 	req, err := s.client.NewRequest("PUT", u, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
 
 // AllowUser allow users to view this video.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/privacy/users/%7Buser_id%7D
-func (s *VideosService) AllowUser(vid int, uid string) (*Response, error) {
+func (s *VideosService) AllowUser(ctx context.Context, vid int, uid string) (*Response, error) {
+	if err := sanitizeID(uid); err != nil {
+		return nil, err
+	}
+
 	u := s.url("%d/privacy/users/%s", vid, uid)
 	req, err := s.client.NewRequest("PUT", u, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
 
 // DisallowUser disallow user from viewing this video.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/privacy/users/%7Buser_id%7D
-func (s *VideosService) DisallowUser(vid int, uid string) (*Response, error) {
+func (s *VideosService) DisallowUser(ctx context.Context, vid int, uid string) (*Response, error) {
+	if err := sanitizeID(uid); err != nil {
+		return nil, err
+	}
+
 	u := s.url("%d/privacy/users/%s", vid, uid)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
 
 // ListTag list a video's tags
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/tags
-func (s *VideosService) ListTag(vid int) ([]*Tag, *Response, error) {
+func (s *VideosService) ListTag(ctx context.Context, vid int) ([]*Tag, *Response, error) {
 	u := s.url("%d/tags", vid)
-	tags, resp, err := listTag(s.client, u)
+	tags, resp, err := listTag(ctx, s.client, u)
 
 	return tags, resp, err
 }
@@ -646,9 +1262,9 @@ func (s *VideosService) ListTag(vid int) ([]*Tag, *Response, error) {
 // GetTag specific tag by name.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/tags/%7Bword%7D
-func (s *VideosService) GetTag(vid int, t string) (*Tag, *Response, error) {
-	u := s.url("%d/tags/%s", vid, t)
-	tag, resp, err := getTag(s.client, u)
+func (s *VideosService) GetTag(ctx context.Context, vid int, t string) (*Tag, *Response, error) {
+	u := s.url("%d/tags/%s", vid, url.PathEscape(t))
+	tag, resp, err := getTag(ctx, s.client, u)
 
 	return tag, resp, err
 }
@@ -656,42 +1272,117 @@ func (s *VideosService) GetTag(vid int, t string) (*Tag, *Response, error) {
 // AssignTag specific tag by name.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/tags/%7Bword%7D
-func (s *VideosService) AssignTag(vid int, t string) (*Response, error) {
-	u := s.url("%d/tags/%s", vid, t)
+func (s *VideosService) AssignTag(ctx context.Context, vid int, t string) (*Response, error) {
+	u := s.url("%d/tags/%s", vid, url.PathEscape(t))
 	req, err := s.client.NewRequest("PUT", u, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
 
 // UnassignTag specific tag by name.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/tags/%7Bword%7D
-func (s *VideosService) UnassignTag(vid int, t string) (*Response, error) {
-	u := s.url("%d/tags/%s", vid, t)
+func (s *VideosService) UnassignTag(ctx context.Context, vid int, t string) (*Response, error) {
+	u := s.url("%d/tags/%s", vid, url.PathEscape(t))
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
+}
+
+type tagRequest struct {
+	Name string `json:"name"`
+}
+
+// AssignTags several tags at once.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/tags
+func (s *VideosService) AssignTags(ctx context.Context, vid int, tags []string) (*Response, error) {
+	body := make([]*tagRequest, len(tags))
+	for i, t := range tags {
+		body[i] = &tagRequest{Name: t}
+	}
+
+	u := s.url("%d/tags", vid)
+	req, err := s.client.NewRequest("PUT", u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// tagsRateLimitRetries is the least number of retries of the rate limited
+// requests of AddTagsToMany.
+const tagsRateLimitRetries = 3
+
+// AddTagsToMany assign the same tags to many videos in parallel.
+// Requests rejected by the rate limit are retried 3 times, or Client.RetryMax
+// times if greater, with the backoff of the client.
+// The returned slice holds the error for each video ID in the same order
+// (nil on success), the second error is not nil if any video failed.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/tags
+func (s *VideosService) AddTagsToMany(ctx context.Context, ids []int, tags []string) ([]error, error) {
+	if len(tags) == 0 {
+		return nil, errors.New("no tags to assign")
+	}
+
+	// The parallel requests are likely to hit the rate limit.
+	ctx = withRateLimitRetries(ctx, tagsRateLimitRetries)
+
+	errs := make([]error, len(ids))
+	sem := make(chan struct{}, s.client.concurrency())
+
+	var wg sync.WaitGroup
+	for i, vid := range ids {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i, vid int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			_, errs[i] = s.AssignTags(ctx, vid, tags)
+		}(i, vid)
+	}
+	wg.Wait()
+
+	failed := 0
+	for _, err := range errs {
+		if err != nil {
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return errs, fmt.Errorf("failed to assign tags to %d of %d videos", failed, len(ids))
+	}
+
+	return errs, nil
 }
 
 // ListRelatedVideo lists the related video.
 //
+// Deprecated: use ListRelated. Like ListRelated, it now sends filter=related
+// and returns an error if opt has another Filter.
+//
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/videos
-func (s *VideosService) ListRelatedVideo(vid int, opt *ListVideoOptions) ([]*Video, *Response, error) {
-	u := s.url("%d/videos", vid)
-	videos, resp, err := listVideo(s.client, u, opt)
-
-	return videos, resp, err
+func (s *VideosService) ListRelatedVideo(ctx context.Context, vid int, opt *ListVideoOptions) ([]*Video, *Response, error) {
+	return s.ListRelated(ctx, vid, opt)
 }
 
 func (s *VideosService) url(suffixFormat string, a ...interface{}) string {
+	if suffixFormat == "" {
+		return s.urlPrefix + "videos"
+	}
 	if !strings.HasPrefix(suffixFormat, "/") {
 		suffixFormat = "/" + suffixFormat
 	}
 	return fmt.Sprintf("%svideos%s", s.urlPrefix, fmt.Sprintf(suffixFormat, a...))
-}
\ No newline at end of file
+}
@@ -0,0 +1,89 @@
+package vimeo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// maxBulkEdit is the number of videos edited by a single request of
+// VideosService.BulkEdit.
+const maxBulkEdit = 100
+
+// VideoBulkChange represents the change of a video edited by
+// VideosService.BulkEdit. The empty fields are left unchanged.
+type VideoBulkChange struct {
+	URI           string          `json:"uri"`
+	Name          string          `json:"name,omitempty"`
+	Description   string          `json:"description,omitempty"`
+	License       string          `json:"license,omitempty"`
+	Privacy       *PrivacyRequest `json:"privacy,omitempty"`
+	Password      string          `json:"password,omitempty"`
+	ContentRating []string        `json:"content_rating,omitempty"`
+}
+
+// BulkEditError reports the videos of a VideosService.BulkEdit call which
+// were rejected, with the error of the request that carried them. The API
+// rejects a batch as a whole: all its videos are listed, not only the ones
+// whose change is invalid.
+type BulkEditError struct {
+	Failed map[string]error
+}
+
+func (e *BulkEditError) Error() string {
+	uris := make([]string, 0, len(e.Failed))
+	for uri := range e.Failed {
+		uris = append(uris, uri)
+	}
+	sort.Strings(uris)
+	return fmt.Sprintf("failed to edit %d videos: %s", len(uris), strings.Join(uris, ", "))
+}
+
+// BulkEdit edits many videos of the authenticated user at once, such as to
+// change the privacy of a whole library. The changes are sent by batches of
+// 100 videos. If some batches are rejected, the returned error is a
+// *BulkEditError listing all the videos of these batches, the failures
+// aren't reported per video, and the other batches are still sent.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/me/videos
+func (s *VideosService) BulkEdit(ctx context.Context, changes []*VideoBulkChange) (*Response, error) {
+	for _, c := range changes {
+		if c == nil || c.URI == "" {
+			return nil, errors.New("each change must have the URI of the video")
+		}
+	}
+
+	failed := make(map[string]error)
+
+	var resp *Response
+	for start := 0; start < len(changes); start += maxBulkEdit {
+		end := start + maxBulkEdit
+		if end > len(changes) {
+			end = len(changes)
+		}
+		batch := changes[start:end]
+
+		req, err := s.client.NewRequest("PATCH", "me/videos", batch)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err = s.client.Do(ctx, req, nil)
+		if err != nil {
+			if ctx.Err() != nil {
+				return resp, err
+			}
+			for _, c := range batch {
+				failed[c.URI] = err
+			}
+		}
+	}
+
+	if len(failed) > 0 {
+		return resp, &BulkEditError{Failed: failed}
+	}
+
+	return resp, nil
+}
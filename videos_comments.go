@@ -1,6 +1,12 @@
 package vimeo
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
 
 type dataListComment struct {
 	Data []*Comment `json:"data,omitempty"`
@@ -9,12 +15,12 @@ type dataListComment struct {
 
 // Comment represents a comment.
 type Comment struct {
-	URI         string `json:"uri,omitempty"`
-	Type        string `json:"type,omitempty"`
-	Text        string `json:"text,omitempty"`
-	CreatedOn   string `json:"created_on,omitempty"`
-	User        *User  `json:"user,omitempty"`
-	ResourceKey string `json:"resource_key,omitempty"`
+	URI         string    `json:"uri,omitempty"`
+	Type        string    `json:"type,omitempty"`
+	Text        string    `json:"text,omitempty"`
+	CreatedOn   Timestamp `json:"created_on,omitempty"`
+	User        *User     `json:"user,omitempty"`
+	ResourceKey string    `json:"resource_key,omitempty"`
 }
 
 // ListCommentOptions specifies the optional parameters to the
@@ -39,34 +45,14 @@ type ListRepliesOptions struct {
 // ListComment lists the comments.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/comments
-func (s *VideosService) ListComment(vid int, opt *ListCommentOptions) ([]*Comment, *Response, error) {
-	u := fmt.Sprintf("videos/%d/comments", vid)
-	u, err := addOptions(u, opt)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	req, err := s.client.NewRequest("GET", u, nil)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	comments := &dataListComment{}
-
-	resp, err := s.client.Do(req, comments)
-	if err != nil {
-		return nil, resp, err
-	}
-
-	resp.setPaging(comments)
-
-	return comments.Data, resp, err
+func (s *VideosService) ListComment(ctx context.Context, vid int, opt *ListCommentOptions) ([]*Comment, *Response, error) {
+	return listComment(ctx, s.client, fmt.Sprintf("videos/%d/comments", vid), opt)
 }
 
 // AddComment add comment.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/comments
-func (s *VideosService) AddComment(vid int, r *CommentRequest) (*Comment, *Response, error) {
+func (s *VideosService) AddComment(ctx context.Context, vid int, r *CommentRequest) (*Comment, *Response, error) {
 	u := fmt.Sprintf("videos/%d/comments", vid)
 	req, err := s.client.NewRequest("POST", u, r)
 	if err != nil {
@@ -74,7 +60,7 @@ func (s *VideosService) AddComment(vid int, r *CommentRequest) (*Comment, *Respo
 	}
 
 	comment := &Comment{}
-	resp, err := s.client.Do(req, comment)
+	resp, err := s.client.Do(ctx, req, comment)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -85,7 +71,7 @@ func (s *VideosService) AddComment(vid int, r *CommentRequest) (*Comment, *Respo
 // GetComment get specific comment by ID.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/comments/%7Bcomment_id%7D
-func (s *VideosService) GetComment(vid int, cid int) (*Comment, *Response, error) {
+func (s *VideosService) GetComment(ctx context.Context, vid int, cid int) (*Comment, *Response, error) {
 	u := fmt.Sprintf("videos/%d/comments/%d", vid, cid)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -94,7 +80,7 @@ func (s *VideosService) GetComment(vid int, cid int) (*Comment, *Response, error
 
 	comment := &Comment{}
 
-	resp, err := s.client.Do(req, comment)
+	resp, err := s.client.Do(ctx, req, comment)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -105,7 +91,7 @@ func (s *VideosService) GetComment(vid int, cid int) (*Comment, *Response, error
 // EditComment edit specific comment by ID.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/comments/%7Bcomment_id%7D
-func (s *VideosService) EditComment(vid int, cid int, r *CommentRequest) (*Comment, *Response, error) {
+func (s *VideosService) EditComment(ctx context.Context, vid int, cid int, r *CommentRequest) (*Comment, *Response, error) {
 	u := fmt.Sprintf("videos/%d/comments/%d", vid, cid)
 	req, err := s.client.NewRequest("PATCH", u, r)
 	if err != nil {
@@ -113,7 +99,7 @@ func (s *VideosService) EditComment(vid int, cid int, r *CommentRequest) (*Comme
 	}
 
 	comment := &Comment{}
-	resp, err := s.client.Do(req, comment)
+	resp, err := s.client.Do(ctx, req, comment)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -124,58 +110,131 @@ func (s *VideosService) EditComment(vid int, cid int, r *CommentRequest) (*Comme
 // DeleteComment delete specific comment by name.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/comments/%7Bcomment_id%7D
-func (s *VideosService) DeleteComment(vid int, cid int) (*Response, error) {
+func (s *VideosService) DeleteComment(ctx context.Context, vid int, cid int) (*Response, error) {
 	u := fmt.Sprintf("videos/%d/comments/%d", vid, cid)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
 
 // ListReplies lists the comment replies.
 //
 // https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/comments/%7Bcomment_id%7D/replies
-func (s *VideosService) ListReplies(vid int, cid int, opt *ListRepliesOptions) ([]*Comment, *Response, error) {
+func (s *VideosService) ListReplies(ctx context.Context, vid int, cid int, opt *ListRepliesOptions) ([]*Comment, *Response, error) {
+	return listComment(ctx, s.client, fmt.Sprintf("videos/%d/comments/%d/replies", vid, cid), opt)
+}
+
+// AddReplies add replies.
+//
+// https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/comments/%7Bcomment_id%7D/replies
+func (s *VideosService) AddReplies(ctx context.Context, vid int, cid int, r *CommentRequest) (*Comment, *Response, error) {
 	u := fmt.Sprintf("videos/%d/comments/%d/replies", vid, cid)
-	u, err := addOptions(u, opt)
+	req, err := s.client.NewRequest("POST", u, r)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", u, nil)
+	replies := &Comment{}
+	resp, err := s.client.Do(ctx, req, replies)
 	if err != nil {
-		return nil, nil, err
+		return nil, resp, err
 	}
 
-	replies := &dataListComment{}
+	return replies, resp, nil
+}
+
+type commentedVideo struct {
+	video *Video
+	time  time.Time
+}
+
+// byCommentTime sorts videos by latest comment time, newest first.
+type byCommentTime []commentedVideo
+
+func (c byCommentTime) Len() int           { return len(c) }
+func (c byCommentTime) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
+func (c byCommentTime) Less(i, j int) bool { return c[i].time.After(c[j].time) }
+
+// mostRecentlyCommentedScan bounds the number of videos inspected by
+// MostRecentlyCommented.
+const mostRecentlyCommentedScan = 100
 
-	resp, err := s.client.Do(req, replies)
+// MostRecentlyCommented lists up to limit videos of user ordered by the time
+// of their latest comment, newest first. Videos without comments are omitted.
+// Passing the empty string will edit authenticated user.
+//
+// The ordering is derived client-side, Vimeo has no native sort for it: only
+// the user's most recent videos are inspected and the latest comment of each
+// is fetched concurrently.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/comments
+func (s *VideosService) MostRecentlyCommented(ctx context.Context, uid string, limit int) ([]*Video, error) {
+	opt := &ListVideoOptions{
+		Sort:        "date",
+		Direction:   "desc",
+		ListOptions: ListOptions{PerPage: mostRecentlyCommentedScan},
+	}
+
+	videos, _, err := s.client.Users.ListVideo(ctx, uid, opt)
 	if err != nil {
-		return nil, resp, err
+		return nil, err
 	}
 
-	resp.setPaging(replies)
+	latest := make([]time.Time, len(videos))
+	errs := make([]error, len(videos))
+	sem := make(chan struct{}, s.client.concurrency())
+
+	var wg sync.WaitGroup
+	for i, v := range videos {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i, vid int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			latest[i], errs[i] = s.latestCommentTime(ctx, vid)
+		}(i, v.GetID())
+	}
+	wg.Wait()
+
+	var ranked byCommentTime
+	for i, v := range videos {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		if !latest[i].IsZero() {
+			ranked = append(ranked, commentedVideo{v, latest[i]})
+		}
+	}
+
+	sort.Stable(ranked)
 
-	return replies.Data, resp, err
+	if limit > 0 && len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+
+	result := make([]*Video, len(ranked))
+	for i, c := range ranked {
+		result[i] = c.video
+	}
+
+	return result, nil
 }
 
-// AddReplies add replies.
-//
-// https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/comments/%7Bcomment_id%7D/replies
-func (s *VideosService) AddReplies(vid int, cid int, r *CommentRequest) (*Comment, *Response, error) {
-	u := fmt.Sprintf("videos/%d/comments/%d/replies", vid, cid)
-	req, err := s.client.NewRequest("POST", u, r)
-	if err != nil {
-		return nil, nil, err
+func (s *VideosService) latestCommentTime(ctx context.Context, vid int) (time.Time, error) {
+	opt := &ListCommentOptions{
+		Direction:   "desc",
+		ListOptions: ListOptions{PerPage: 1},
 	}
 
-	replies := &Comment{}
-	resp, err := s.client.Do(req, replies)
-	if err != nil {
-		return nil, resp, err
+	comments, _, err := s.ListComment(ctx, vid, opt)
+	if err != nil || len(comments) == 0 {
+		return time.Time{}, err
 	}
 
-	return replies, resp, nil
+	return comments[0].CreatedOn.Time, nil
 }
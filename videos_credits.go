@@ -1,19 +1,22 @@
 package vimeo
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 type dataListCredit struct {
 	Data []*Credit `json:"data,omitempty"`
 	pagination
 }
 
-// Credit represents a creadit.
+// Credit represents a credit.
 type Credit struct {
 	URI   string `json:"uri,omitempty"`
 	Name  string `json:"name,omitempty"`
 	Role  string `json:"role,omitempty"`
 	User  *User  `json:"user,omitempty"`
-	Video *Video `json:"user,omitempty"`
+	Video *Video `json:"video,omitempty"`
 }
 
 // ListCreditOptions specifies the optional parameters to the ListCredit method.
@@ -24,7 +27,7 @@ type ListCreditOptions struct {
 	ListOptions
 }
 
-// CreditRequest represents a request to create/edit an creadit.
+// CreditRequest represents a request to create/edit a credit.
 type CreditRequest struct {
 	Role    string `json:"role,omitempty"`
 	Name    string `json:"name,omitempty"`
@@ -35,7 +38,7 @@ type CreditRequest struct {
 // ListCredit lists the credits.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/credits
-func (s *VideosService) ListCredit(vid int, opt *ListCreditOptions) ([]*Credit, *Response, error) {
+func (s *VideosService) ListCredit(ctx context.Context, vid int, opt *ListCreditOptions) ([]*Credit, *Response, error) {
 	u := fmt.Sprintf("videos/%d/credits", vid)
 	u, err := addOptions(u, opt)
 	if err != nil {
@@ -49,7 +52,7 @@ func (s *VideosService) ListCredit(vid int, opt *ListCreditOptions) ([]*Credit,
 
 	credits := &dataListCredit{}
 
-	resp, err := s.client.Do(req, credits)
+	resp, err := s.client.Do(ctx, req, credits)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -62,7 +65,7 @@ func (s *VideosService) ListCredit(vid int, opt *ListCreditOptions) ([]*Credit,
 // AddCredit add credit.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/credits
-func (s *VideosService) AddCredit(vid int, r *CreditRequest) (*Credit, *Response, error) {
+func (s *VideosService) AddCredit(ctx context.Context, vid int, r *CreditRequest) (*Credit, *Response, error) {
 	u := fmt.Sprintf("videos/%d/credits", vid)
 	req, err := s.client.NewRequest("POST", u, r)
 	if err != nil {
@@ -70,7 +73,7 @@ func (s *VideosService) AddCredit(vid int, r *CreditRequest) (*Credit, *Response
 	}
 
 	credit := &Credit{}
-	resp, err := s.client.Do(req, credit)
+	resp, err := s.client.Do(ctx, req, credit)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -81,7 +84,7 @@ func (s *VideosService) AddCredit(vid int, r *CreditRequest) (*Credit, *Response
 // GetCredit get specific credit by ID.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/credits/%7Bcredit_id%7D
-func (s *VideosService) GetCredit(vid int, cid int) (*Credit, *Response, error) {
+func (s *VideosService) GetCredit(ctx context.Context, vid int, cid int) (*Credit, *Response, error) {
 	u := fmt.Sprintf("videos/%d/credits/%d", vid, cid)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -90,7 +93,7 @@ func (s *VideosService) GetCredit(vid int, cid int) (*Credit, *Response, error)
 
 	credit := &Credit{}
 
-	resp, err := s.client.Do(req, credit)
+	resp, err := s.client.Do(ctx, req, credit)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -101,7 +104,7 @@ func (s *VideosService) GetCredit(vid int, cid int) (*Credit, *Response, error)
 // EditCredit edit specific credit by ID.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/credits/%7Bcredit_id%7D
-func (s *VideosService) EditCredit(vid int, cid int, r *CreditRequest) (*Credit, *Response, error) {
+func (s *VideosService) EditCredit(ctx context.Context, vid int, cid int, r *CreditRequest) (*Credit, *Response, error) {
 	u := fmt.Sprintf("videos/%d/credits/%d", vid, cid)
 	req, err := s.client.NewRequest("PATCH", u, r)
 	if err != nil {
@@ -109,7 +112,7 @@ func (s *VideosService) EditCredit(vid int, cid int, r *CreditRequest) (*Credit,
 	}
 
 	credit := &Credit{}
-	resp, err := s.client.Do(req, credit)
+	resp, err := s.client.Do(ctx, req, credit)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -120,12 +123,12 @@ func (s *VideosService) EditCredit(vid int, cid int, r *CreditRequest) (*Credit,
 // DeleteCredit delete specific credit by ID.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/credits/%7Bcredit_id%7D
-func (s *VideosService) DeleteCredit(vid int, cid int) (*Response, error) {
+func (s *VideosService) DeleteCredit(ctx context.Context, vid int, cid int) (*Response, error) {
 	u := fmt.Sprintf("videos/%d/credits/%d", vid, cid)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
@@ -0,0 +1,74 @@
+package vimeo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Download internal object provides access to a downloadable rendition of video.
+type Download struct {
+	Quality string    `json:"quality,omitempty"`
+	Type    string    `json:"type,omitempty"`
+	Width   int       `json:"width,omitempty"`
+	Height  int       `json:"height,omitempty"`
+	Size    int64     `json:"size,omitempty"`
+	Link    string    `json:"link,omitempty"`
+	Expires Timestamp `json:"expires,omitempty"`
+}
+
+// Expired reports whether the link of the rendition is no longer valid.
+func (d *Download) Expired() bool {
+	return !d.Expires.IsZero() && !time.Now().Before(d.Expires.Time)
+}
+
+// Download lists the downloadable renditions of video. The links are only
+// exposed to the owner of the video on paid accounts and expire after a while.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D
+func (s *VideosService) Download(ctx context.Context, vid int) ([]*Download, *Response, error) {
+	video, resp, err := s.Get(WithFields(ctx, "download"), vid)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return video.Download, resp, nil
+}
+
+// DownloadTo streams the rendition of video with the given quality to w.
+// The links are fetched anew if the one found has already expired.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D
+func (s *VideosService) DownloadTo(ctx context.Context, vid int, quality string, w io.Writer) (*Response, error) {
+	var d *Download
+	for attempt := 0; attempt < 2 && (d == nil || d.Expired()); attempt++ {
+		downloads, resp, err := s.Download(ctx, vid)
+		if err != nil {
+			return resp, err
+		}
+
+		d = nil
+		for _, v := range downloads {
+			if v.Quality == quality {
+				d = v
+				break
+			}
+		}
+		if d == nil {
+			return resp, fmt.Errorf("video %d has no %q download", vid, quality)
+		}
+	}
+
+	if d.Expired() {
+		return nil, fmt.Errorf("the %q download link of video %d has expired", quality, vid)
+	}
+
+	req, err := http.NewRequest("GET", d.Link, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, w)
+}
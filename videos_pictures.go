@@ -1,6 +1,12 @@
 package vimeo
 
-import "fmt"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
 
 type dataListPictures struct {
 	Data []*Pictures `json:"data,omitempty"`
@@ -13,6 +19,7 @@ type Pictures struct {
 	Active      bool           `json:"active"`
 	Type        string         `json:"type,omitempty"`
 	Sizes       []*PictureSize `json:"sizes,omitempty"`
+	Link        string         `json:"link,omitempty"`
 	ResourceKey string         `json:"resource_key,omitempty"`
 }
 
@@ -39,10 +46,68 @@ type PicturesRequest struct {
 	Active bool    `json:"active,omitempty"`
 }
 
+// frameThumbnailRequest generates an active thumbnail from the frame at Time.
+// Unlike PicturesRequest, the time of the first frame is sent too, else an
+// empty picture waiting for an upload is created.
+type frameThumbnailRequest struct {
+	Time   float64 `json:"time"`
+	Active bool    `json:"active"`
+}
+
+// ThumbnailSource reports how a thumbnail was set by SetThumbnail.
+type ThumbnailSource string
+
+// Possible values of ThumbnailSource.
+const (
+	ThumbnailUploaded ThumbnailSource = "uploaded"
+	ThumbnailFrame    ThumbnailSource = "frame"
+)
+
+// uploadPictures creates a picture resource at the uri, uploads the image
+// to the returned link and activates the picture.
+func uploadPictures(ctx context.Context, c *Client, uri string, img io.Reader) (*Pictures, *Response, error) {
+	req, err := c.NewRequest("POST", uri, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pictures := &Pictures{}
+	resp, err := c.Do(ctx, req, pictures)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if pictures.Link == "" {
+		return nil, resp, errors.New("the picture upload link is missing")
+	}
+
+	req, err = http.NewRequest("PUT", pictures.Link, img)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err = c.Do(ctx, req, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	req, err = c.NewRequest("PATCH", pictures.URI, &PicturesRequest{Active: true})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err = c.Do(ctx, req, pictures)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pictures, resp, nil
+}
+
 // ListPictures lists thumbnails.
 //
 // https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/pictures
-func (s *VideosService) ListPictures(vid int) ([]*Pictures, *Response, error) {
+func (s *VideosService) ListPictures(ctx context.Context, vid int) ([]*Pictures, *Response, error) {
 	u := fmt.Sprintf("videos/%d/pictures", vid)
 
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -52,7 +117,7 @@ func (s *VideosService) ListPictures(vid int) ([]*Pictures, *Response, error) {
 
 	pictures := &dataListPictures{}
 
-	resp, err := s.client.Do(req, pictures)
+	resp, err := s.client.Do(ctx, req, pictures)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -65,7 +130,7 @@ func (s *VideosService) ListPictures(vid int) ([]*Pictures, *Response, error) {
 // CreatePictures create a thumbnail.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/pictures
-func (s *VideosService) CreatePictures(vid int, r *PicturesRequest) (*Pictures, *Response, error) {
+func (s *VideosService) CreatePictures(ctx context.Context, vid int, r *PicturesRequest) (*Pictures, *Response, error) {
 	u := fmt.Sprintf("videos/%d/pictures", vid)
 	req, err := s.client.NewRequest("POST", u, r)
 	if err != nil {
@@ -73,7 +138,7 @@ func (s *VideosService) CreatePictures(vid int, r *PicturesRequest) (*Pictures,
 	}
 
 	pictures := &Pictures{}
-	resp, err := s.client.Do(req, pictures)
+	resp, err := s.client.Do(ctx, req, pictures)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -84,7 +149,7 @@ func (s *VideosService) CreatePictures(vid int, r *PicturesRequest) (*Pictures,
 // GetPictures get one thumbnail.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/pictures
-func (s *VideosService) GetPictures(vid int, pid int) (*Pictures, *Response, error) {
+func (s *VideosService) GetPictures(ctx context.Context, vid int, pid int) (*Pictures, *Response, error) {
 	u := fmt.Sprintf("videos/%d/pictures/%d", vid, pid)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -93,7 +158,7 @@ func (s *VideosService) GetPictures(vid int, pid int) (*Pictures, *Response, err
 
 	pictures := &Pictures{}
 
-	resp, err := s.client.Do(req, pictures)
+	resp, err := s.client.Do(ctx, req, pictures)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -104,7 +169,7 @@ func (s *VideosService) GetPictures(vid int, pid int) (*Pictures, *Response, err
 // EditPictures edit specific pictures by ID.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/pictures/%7Bpicture_id%7D
-func (s *VideosService) EditPictures(vid int, pid int, r *PicturesRequest) (*Pictures, *Response, error) {
+func (s *VideosService) EditPictures(ctx context.Context, vid int, pid int, r *PicturesRequest) (*Pictures, *Response, error) {
 	u := fmt.Sprintf("videos/%d/pictures/%d", vid, pid)
 	req, err := s.client.NewRequest("PATCH", u, r)
 	if err != nil {
@@ -112,7 +177,7 @@ func (s *VideosService) EditPictures(vid int, pid int, r *PicturesRequest) (*Pic
 	}
 
 	pictures := &Pictures{}
-	resp, err := s.client.Do(req, pictures)
+	resp, err := s.client.Do(ctx, req, pictures)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -123,12 +188,86 @@ func (s *VideosService) EditPictures(vid int, pid int, r *PicturesRequest) (*Pic
 // DeletePictures delete specific pictures by ID.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/comments/%7Bcomment_id%7D
-func (s *VideosService) DeletePictures(vid int, pid int) (*Response, error) {
+func (s *VideosService) DeletePictures(ctx context.Context, vid int, pid int) (*Response, error) {
 	u := fmt.Sprintf("videos/%d/pictures/%d", vid, pid)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
+}
+
+// UploadThumbnail uploads the image as a new thumbnail and makes it active.
+// To generate the thumbnail from a frame use GenerateThumbnail instead.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/upload/thumbnails
+func (s *VideosService) UploadThumbnail(ctx context.Context, vid int, img io.Reader) (*Pictures, *Response, error) {
+	u := fmt.Sprintf("videos/%d/pictures", vid)
+	return uploadPictures(ctx, s.client, u, img)
+}
+
+// SetThumbnail set the thumbnail from the uploaded image. If the upload fails
+// (or img is nil), the thumbnail is generated from the frame at fallbackSeconds.
+// Returns which of the two methods succeeded.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/pictures
+func (s *VideosService) SetThumbnail(ctx context.Context, vid int, img io.Reader, fallbackSeconds float64) (*Pictures, ThumbnailSource, error) {
+	var uploadErr error
+	if img != nil {
+		pictures, _, err := s.UploadThumbnail(ctx, vid, img)
+		if err == nil {
+			return pictures, ThumbnailUploaded, nil
+		}
+		uploadErr = err
+	}
+
+	pictures, _, err := s.createFrameThumbnail(ctx, vid, fallbackSeconds)
+	if err != nil {
+		if uploadErr != nil {
+			return nil, "", fmt.Errorf("upload failed: %v; frame fallback failed: %v", uploadErr, err)
+		}
+		return nil, "", err
+	}
+
+	return pictures, ThumbnailFrame, nil
+}
+
+// GenerateThumbnail generates the thumbnail from the frame at atSeconds and
+// makes it active. The duration of the video is fetched first, an error is
+// returned if atSeconds is out of it.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/pictures
+func (s *VideosService) GenerateThumbnail(ctx context.Context, vid int, atSeconds float64) (*Pictures, *Response, error) {
+	if atSeconds < 0 {
+		return nil, nil, fmt.Errorf("invalid thumbnail time %v, must not be negative", atSeconds)
+	}
+
+	video, resp, err := s.Get(WithFields(ctx, "duration"), vid)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if atSeconds > float64(video.Duration) {
+		return nil, resp, fmt.Errorf("invalid thumbnail time %v, the video %d lasts %d seconds", atSeconds, vid, video.Duration)
+	}
+
+	return s.createFrameThumbnail(ctx, vid, atSeconds)
+}
+
+// createFrameThumbnail creates the active thumbnail from the frame at seconds.
+func (s *VideosService) createFrameThumbnail(ctx context.Context, vid int, seconds float64) (*Pictures, *Response, error) {
+	u := fmt.Sprintf("videos/%d/pictures", vid)
+	req, err := s.client.NewRequest("POST", u, &frameThumbnailRequest{Time: seconds, Active: true})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pictures := &Pictures{}
+	resp, err := s.client.Do(ctx, req, pictures)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pictures, resp, nil
 }
@@ -1,6 +1,6 @@
 package vimeo
 
-import "fmt"
+import "context"
 
 type dataListPreset struct {
 	Data []*Preset `json:"data,omitempty"`
@@ -9,8 +9,47 @@ type dataListPreset struct {
 
 // Preset represents a preset.
 type Preset struct {
-	URI  string `json:"uri,omitempty"`
-	Name string `json:"name,omitempty"`
+	URI      string          `json:"uri,omitempty"`
+	Name     string          `json:"name,omitempty"`
+	Settings *PresetSettings `json:"settings,omitempty"`
+}
+
+// PresetSettings internal object provides access to the player settings of a preset.
+type PresetSettings struct {
+	Buttons *PresetButtons `json:"buttons,omitempty"`
+	Logos   *PresetLogos   `json:"logos,omitempty"`
+	Outro   *PresetOutro   `json:"outro,omitempty"`
+}
+
+// PresetButtons internal object provides access to the player buttons of a preset.
+type PresetButtons struct {
+	Like       bool `json:"like"`
+	WatchLater bool `json:"watchlater"`
+	Share      bool `json:"share"`
+	Embed      bool `json:"embed"`
+	HD         bool `json:"hd"`
+	Fullscreen bool `json:"fullscreen"`
+	Scaling    bool `json:"scaling"`
+}
+
+// PresetLogos internal object provides access to the player logos of a preset.
+type PresetLogos struct {
+	Vimeo  bool              `json:"vimeo"`
+	Custom *PresetCustomLogo `json:"custom,omitempty"`
+}
+
+// PresetCustomLogo internal object provides access to the custom logo of a preset.
+type PresetCustomLogo struct {
+	Active bool   `json:"active"`
+	Link   string `json:"link,omitempty"`
+	Sticky bool   `json:"sticky"`
+}
+
+// PresetOutro internal object provides access to the end screen of a preset.
+type PresetOutro struct {
+	Type string `json:"type,omitempty"`
+	Link string `json:"link,omitempty"`
+	Text string `json:"text,omitempty"`
 }
 
 // ListPresetOptions specifies the optional parameters to the
@@ -23,15 +62,13 @@ type ListPresetOptions struct {
 // ListPreset lists the preset for an current user.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/presets
-func (s *UsersService) ListPreset(uid string, opt *ListPresetOptions) ([]*Preset, *Response, error) {
-	var u string
-	if uid == "" {
-		u = fmt.Sprintf("me/presets")
-	} else {
-		u = fmt.Sprintf("users/%s/presets", uid)
+func (s *UsersService) ListPreset(ctx context.Context, uid string, opt *ListPresetOptions) ([]*Preset, *Response, error) {
+	u, err := userPath(uid, "presets")
+	if err != nil {
+		return nil, nil, err
 	}
 
-	u, err := addOptions(u, opt)
+	u, err = addOptions(u, opt)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -43,7 +80,7 @@ func (s *UsersService) ListPreset(uid string, opt *ListPresetOptions) ([]*Preset
 
 	preset := &dataListPreset{}
 
-	resp, err := s.client.Do(req, preset)
+	resp, err := s.client.Do(ctx, req, preset)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -56,12 +93,10 @@ func (s *UsersService) ListPreset(uid string, opt *ListPresetOptions) ([]*Preset
 // GetPreset get preset by name.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/presets/%7Bpreset_id%7D
-func (s *UsersService) GetPreset(uid string, p int) (*Preset, *Response, error) {
-	var u string
-	if uid == "" {
-		u = fmt.Sprintf("me/presets/%d", p)
-	} else {
-		u = fmt.Sprintf("users/%s/presets/%d", uid, p)
+func (s *UsersService) GetPreset(ctx context.Context, uid string, p int) (*Preset, *Response, error) {
+	u, err := userPath(uid, "presets/%d", p)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	req, err := s.client.NewRequest("GET", u, nil)
@@ -71,7 +106,7 @@ func (s *UsersService) GetPreset(uid string, p int) (*Preset, *Response, error)
 
 	portf := &Preset{}
 
-	resp, err := s.client.Do(req, portf)
+	resp, err := s.client.Do(ctx, req, portf)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -82,15 +117,13 @@ func (s *UsersService) GetPreset(uid string, p int) (*Preset, *Response, error)
 // PresetListVideo lists the preset for an preset.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/users/%7Buser_id%7D/presets/%7Bpreset_id%7D/videos
-func (s *UsersService) PresetListVideo(uid string, p int, opt *ListVideoOptions) ([]*Video, *Response, error) {
-	var u string
-	if uid == "" {
-		u = fmt.Sprintf("me/presets/%d/videos", p)
-	} else {
-		u = fmt.Sprintf("users/%s/presets/%d/videos", uid, p)
+func (s *UsersService) PresetListVideo(ctx context.Context, uid string, p int, opt *ListVideoOptions) ([]*Video, *Response, error) {
+	u, err := userPath(uid, "presets/%d/videos", p)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	videos, resp, err := listVideo(s.client, u, opt)
+	videos, resp, err := listVideo(ctx, s.client, u, opt)
 
 	return videos, resp, err
 }
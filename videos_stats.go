@@ -0,0 +1,60 @@
+package vimeo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type dataListDailyStats struct {
+	Data []*DailyStats `json:"data,omitempty"`
+	pagination
+}
+
+// DailyStats represents the statistic of a video for one day.
+type DailyStats struct {
+	Date  string `json:"date,omitempty"`
+	Plays int    `json:"plays,omitempty"`
+}
+
+// StatsOptions specifies the optional parameters to the
+// Stats method. Start and End are dates in the YYYY-MM-DD format.
+type StatsOptions struct {
+	Start string `url:"start,omitempty"`
+	End   string `url:"end,omitempty"`
+	ListOptions
+}
+
+// Stats lists the plays of the video per day. The total play count is
+// available without this call in Video.Stats.
+//
+// The time series is only exposed on some account tiers, otherwise the
+// returned error says so and wraps the *ErrorResponse.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/stats
+func (s *VideosService) Stats(ctx context.Context, vid int, opt *StatsOptions) ([]*DailyStats, *Response, error) {
+	u := fmt.Sprintf("videos/%d/stats", vid)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stats := &dataListDailyStats{}
+
+	resp, err := s.client.Do(ctx, req, stats)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusForbidden {
+			err = fmt.Errorf("video stats are not available for this account: %w", err)
+		}
+		return nil, resp, err
+	}
+
+	resp.setPaging(stats)
+
+	return stats.Data, resp, err
+}
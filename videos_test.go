@@ -1,11 +1,20 @@
 package vimeo
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
+	"net/url"
 	"reflect"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestVideo_GetID(t *testing.T) {
@@ -16,6 +25,47 @@ func TestVideo_GetID(t *testing.T) {
 	}
 }
 
+func TestVideo_Embeddable(t *testing.T) {
+	tests := []struct {
+		video *Video
+		want  bool
+	}{
+		{&Video{}, false},
+		{&Video{Privacy: &Privacy{Embed: "public"}}, true},
+		{&Video{Privacy: &Privacy{Embed: "private"}}, false},
+		{&Video{Privacy: &Privacy{Embed: "whitelist"}}, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.video.Embeddable(); got != tt.want {
+			t.Errorf("Video.Embeddable for %+v returned %v, want %v", tt.video.Privacy, got, tt.want)
+		}
+	}
+}
+
+func TestVideo_InWatchLater(t *testing.T) {
+	var v *Video
+	json.Unmarshal([]byte(`{"metadata": {"interactions": {"watchlater": {"added": true, "added_time": "2017-01-01T00:00:00+00:00"}}}}`), &v)
+
+	if !v.InWatchLater() {
+		t.Error("Video.InWatchLater returned false, want true")
+	}
+
+	if (Video{}).InWatchLater() {
+		t.Error("Video.InWatchLater returned true for video without metadata, want false")
+	}
+}
+
+func TestVideo_HiddenFromVimeo(t *testing.T) {
+	if !(Video{Privacy: &Privacy{View: "disable"}}).HiddenFromVimeo() {
+		t.Error("Video.HiddenFromVimeo returned false, want true")
+	}
+
+	if (Video{Privacy: &Privacy{View: "anybody"}}).HiddenFromVimeo() {
+		t.Error("Video.HiddenFromVimeo returned true, want false")
+	}
+}
+
 func TestVideosService_List(t *testing.T) {
 	setup()
 	defer teardown()
@@ -32,7 +82,7 @@ func TestVideosService_List(t *testing.T) {
 	opt := &ListVideoOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	videos, _, err := client.Videos.List(opt)
+	videos, _, err := client.Videos.List(context.Background(), opt)
 	if err != nil {
 		t.Errorf("Videos.List returned unexpected error: %v", err)
 	}
@@ -43,6 +93,188 @@ func TestVideosService_List(t *testing.T) {
 	}
 }
 
+func TestVideosService_List_sort(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var got url.Values
+	mux.HandleFunc("/videos", func(w http.ResponseWriter, r *http.Request) {
+		got = r.URL.Query()
+		fmt.Fprint(w, `{"data": []}`)
+	})
+
+	for _, sort := range []string{"date", "alphabetical", "plays", "likes", "duration", "modified_time", "default"} {
+		opt := &ListVideoOptions{Sort: sort, Direction: "desc"}
+		if _, _, err := client.Videos.List(context.Background(), opt); err != nil {
+			t.Errorf("Videos.List with sort %q returned unexpected error: %v", sort, err)
+			continue
+		}
+
+		if got.Get("sort") != sort || got.Get("direction") != "desc" {
+			t.Errorf("Videos.List with sort %q sent %v", sort, got)
+		}
+	}
+}
+
+func TestVideosService_List_invalidOptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for _, opt := range []*ListVideoOptions{{Direction: "up"}, {Sort: "date", Direction: "ascending"}} {
+		if _, _, err := client.Videos.List(context.Background(), opt); err == nil {
+			t.Errorf("Videos.List(%+v) expected error to be returned", opt)
+		}
+	}
+}
+
+func TestVideosService_ListRelated(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"filter":   "related",
+			"per_page": "2",
+		})
+		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
+	})
+
+	opt := &ListVideoOptions{ListOptions: ListOptions{PerPage: 2}}
+	videos, _, err := client.Videos.ListRelated(context.Background(), 1, opt)
+	if err != nil {
+		t.Errorf("Videos.ListRelated returned unexpected error: %v", err)
+	}
+
+	want := []*Video{{Name: "Test"}}
+	if !reflect.DeepEqual(videos, want) {
+		t.Errorf("Videos.ListRelated returned %+v, want %+v", videos, want)
+	}
+}
+
+func TestVideosService_ListRelated_forbidden(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/videos", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"error": "Forbidden"}`)
+	})
+
+	_, _, err := client.Videos.ListRelated(context.Background(), 1, nil)
+	if !errors.Is(err, ErrRelatedUnavailable) {
+		t.Errorf("Videos.ListRelated returned error %v, want %v", err, ErrRelatedUnavailable)
+	}
+	if !errors.Is(err, ErrForbidden) {
+		t.Errorf("Videos.ListRelated returned error %v, want %v", err, ErrForbidden)
+	}
+}
+
+func TestVideosService_ListRelated_invalidFilter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/videos", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Videos.ListRelated sent a request with another filter")
+	})
+
+	opt := &ListVideoOptions{Filter: "embeddable"}
+	if _, _, err := client.Videos.ListRelated(context.Background(), 1, opt); err == nil {
+		t.Error("Videos.ListRelated expected error")
+	}
+}
+
+func TestVideosService_Search(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"query":              "cats",
+			"filter":             "upload_date",
+			"filter_upload_date": "week",
+			"sort":               "plays",
+			"direction":          "desc",
+			"page":               "2",
+		})
+		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
+	})
+
+	opt := &SearchVideoOptions{
+		Query:            "cats",
+		Filter:           "upload_date",
+		FilterUploadDate: "week",
+		Sort:             "plays",
+		Direction:        "desc",
+		ListOptions:      ListOptions{Page: 2},
+	}
+	videos, _, err := client.MeVideos.Search(context.Background(), opt)
+	if err != nil {
+		t.Errorf("Videos.Search returned unexpected error: %v", err)
+	}
+
+	want := []*Video{{Name: "Test"}}
+	if !reflect.DeepEqual(videos, want) {
+		t.Errorf("Videos.Search returned %+v, want %+v", videos, want)
+	}
+}
+
+func TestVideosService_Search_invalidOptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for _, opt := range []*SearchVideoOptions{{Sort: "views"}, {Direction: "up"}} {
+		if _, _, err := client.Videos.Search(context.Background(), opt); err == nil {
+			t.Errorf("Videos.Search(%+v) expected error to be returned", opt)
+		}
+	}
+}
+
+func TestVideosService_List_authenticatedUser(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
+	})
+
+	videos, _, err := client.MeVideos.List(context.Background(), nil)
+	if err != nil {
+		t.Errorf("MeVideos.List returned unexpected error: %v", err)
+	}
+
+	want := []*Video{{Name: "Test"}}
+	if !reflect.DeepEqual(videos, want) {
+		t.Errorf("MeVideos.List returned %+v, want %+v", videos, want)
+	}
+}
+
+func TestVideosService_List_filters(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"filter_playable":   "true",
+			"filter_embeddable": "false",
+			"privacy":           "unlisted",
+		})
+		fmt.Fprint(w, `{"data": []}`)
+	})
+
+	opt := &ListVideoOptions{
+		FilterPlayable:   Bool(true),
+		FilterEmbeddable: Bool(false),
+		Privacy:          "unlisted",
+	}
+	if _, _, err := client.MeVideos.List(context.Background(), opt); err != nil {
+		t.Errorf("MeVideos.List returned unexpected error: %v", err)
+	}
+}
+
 func TestVideosService_Get(t *testing.T) {
 	setup()
 	defer teardown()
@@ -52,7 +284,7 @@ func TestVideosService_Get(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	video, _, err := client.Videos.Get(1)
+	video, _, err := client.Videos.Get(context.Background(), 1)
 	if err != nil {
 		t.Errorf("Videos.Get returned unexpected error: %v", err)
 	}
@@ -63,6 +295,96 @@ func TestVideosService_Get(t *testing.T) {
 	}
 }
 
+func TestVideosService_GetByURI(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name": "Test"}`)
+	})
+
+	video, _, err := client.Videos.GetByURI(context.Background(), "/videos/1")
+	if err != nil {
+		t.Errorf("Videos.GetByURI returned unexpected error: %v", err)
+	}
+
+	want := &Video{Name: "Test"}
+	if !reflect.DeepEqual(video, want) {
+		t.Errorf("Videos.GetByURI returned %+v, want %+v", video, want)
+	}
+}
+
+func TestVideosService_Download(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"fields": "download"})
+		fmt.Fprint(w, `{"download": [{"quality": "hd", "type": "video/mp4", "width": 1920, "height": 1080, "size": 42, "link": "https://example.com/hd"}]}`)
+	})
+
+	downloads, _, err := client.Videos.Download(context.Background(), 1)
+	if err != nil {
+		t.Errorf("Videos.Download returned unexpected error: %v", err)
+	}
+
+	want := []*Download{{Quality: "hd", Type: "video/mp4", Width: 1920, Height: 1080, Size: 42, Link: "https://example.com/hd"}}
+	if !reflect.DeepEqual(downloads, want) {
+		t.Errorf("Videos.Download returned %+v, want %+v", downloads, want)
+	}
+}
+
+func TestVideosService_DownloadTo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		expires := time.Now().Add(time.Hour)
+		if calls == 1 {
+			expires = time.Now().Add(-time.Hour)
+		}
+		fmt.Fprintf(w, `{"download": [{"quality": "sd", "link": "%s/sd"}, {"quality": "hd", "link": "%s/hd/%d", "expires": %q}]}`,
+			server.URL, server.URL, calls, expires.Format(time.RFC3339))
+	})
+
+	mux.HandleFunc("/hd/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, "video")
+	})
+
+	var buf bytes.Buffer
+	_, err := client.Videos.DownloadTo(context.Background(), 1, "hd", &buf)
+	if err != nil {
+		t.Errorf("Videos.DownloadTo returned unexpected error: %v", err)
+	}
+
+	if got, want := buf.String(), "video"; got != want {
+		t.Errorf("Videos.DownloadTo wrote %q, want %q", got, want)
+	}
+
+	if calls != 2 {
+		t.Errorf("Videos.DownloadTo fetched the links %d times, want 2", calls)
+	}
+}
+
+func TestVideosService_DownloadTo_missingQuality(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"download": [{"quality": "sd", "link": "https://example.com/sd"}]}`)
+	})
+
+	_, err := client.Videos.DownloadTo(context.Background(), 1, "hd", ioutil.Discard)
+	if err == nil {
+		t.Error("Videos.DownloadTo expected error")
+	}
+}
+
 func TestVideosService_Edit(t *testing.T) {
 	setup()
 	defer teardown()
@@ -84,7 +406,7 @@ func TestVideosService_Edit(t *testing.T) {
 		fmt.Fprint(w, `{"name": "name"}`)
 	})
 
-	video, _, err := client.Videos.Edit(1, input)
+	video, _, err := client.Videos.Edit(context.Background(), 1, input)
 	if err != nil {
 		t.Errorf("Videos.Edit returned unexpected error: %v", err)
 	}
@@ -95,6 +417,168 @@ func TestVideosService_Edit(t *testing.T) {
 	}
 }
 
+func TestVideosService_Edit_privacy(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &VideoRequest{
+		Privacy: &PrivacyRequest{View: "contacts", Embed: "whitelist", Comments: "nobody", Download: Bool(true), Add: Bool(true)},
+	}
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		v := &VideoRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PATCH")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Videos.Edit body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"privacy": {"view": "contacts", "embed": "whitelist", "comments": "nobody", "download": true, "add": true}}`)
+	})
+
+	video, _, err := client.Videos.Edit(context.Background(), 1, input)
+	if err != nil {
+		t.Errorf("Videos.Edit returned unexpected error: %v", err)
+	}
+
+	want := &Video{Privacy: &Privacy{View: "contacts", Embed: "whitelist", Comments: "nobody", Download: true, Add: true}}
+	if !reflect.DeepEqual(video, want) {
+		t.Errorf("Videos.Edit returned %+v, want %+v", video, want)
+	}
+}
+
+func TestVideosService_Edit_hiddenForbidden(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"error": "forbidden"}`)
+	})
+
+	_, _, err := client.Videos.Edit(context.Background(), 1, &VideoRequest{Privacy: &PrivacyRequest{View: "disable"}})
+	if err == nil || !strings.Contains(err.Error(), "requires a paid account") {
+		t.Errorf("Videos.Edit returned error %v, want paid account error", err)
+	}
+
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Errorf("Videos.Edit error %v does not wrap *ErrorResponse", err)
+	}
+}
+
+func TestVideosService_Edit_hideFromVimeo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		v := &VideoRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PATCH")
+		want := &VideoRequest{Privacy: &PrivacyRequest{View: "disable", Embed: "public"}}
+		if !reflect.DeepEqual(v, want) {
+			t.Errorf("Videos.Edit body is %+v, want %+v", v, want)
+		}
+
+		fmt.Fprint(w, `{"privacy": {"view": "disable"}}`)
+	})
+
+	input := &VideoRequest{HideFromVimeo: true, Privacy: &PrivacyRequest{Embed: "public"}}
+	video, _, err := client.Videos.Edit(context.Background(), 1, input)
+	if err != nil {
+		t.Errorf("Videos.Edit returned unexpected error: %v", err)
+	}
+
+	if !video.HiddenFromVimeo() {
+		t.Error("Videos.Edit returned video not hidden from Vimeo")
+	}
+	if input.Privacy.View != "" {
+		t.Error("Videos.Edit modified the request privacy")
+	}
+}
+
+func TestVideosService_Edit_hideFromVimeoKeepsPrivacy(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]json.RawMessage{}
+		json.NewDecoder(r.Body).Decode(&body)
+
+		if got, want := string(body["privacy"]), `{"view":"disable"}`; got != want {
+			t.Errorf("Videos.Edit privacy is %s, want %s", got, want)
+		}
+
+		fmt.Fprint(w, `{}`)
+	})
+
+	if _, _, err := client.Videos.Edit(context.Background(), 1, &VideoRequest{HideFromVimeo: true}); err != nil {
+		t.Errorf("Videos.Edit returned unexpected error: %v", err)
+	}
+}
+
+func TestVideosService_Edit_hideFromVimeoInvalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for _, input := range []*VideoRequest{
+		{HideFromVimeo: true, Privacy: &PrivacyRequest{View: "anybody"}},
+		{HideFromVimeo: true, Password: "secret"},
+	} {
+		_, _, err := client.Videos.Edit(context.Background(), 1, input)
+		if err == nil {
+			t.Errorf("Videos.Edit(%+v) expected error", input)
+		}
+	}
+}
+
+func TestVideosService_Edit_spatial(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &VideoRequest{
+		Spatial: &Spatial{Projection: "equirectangular", StereoFormat: "mono", FieldOfView: 90},
+	}
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		v := &VideoRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PATCH")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Videos.Edit body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"spatial": {"projection": "equirectangular", "stereo_format": "mono", "field_of_view": 90}}`)
+	})
+
+	video, _, err := client.Videos.Edit(context.Background(), 1, input)
+	if err != nil {
+		t.Errorf("Videos.Edit returned unexpected error: %v", err)
+	}
+
+	want := &Video{Spatial: input.Spatial}
+	if !reflect.DeepEqual(video, want) {
+		t.Errorf("Videos.Edit returned %+v, want %+v", video, want)
+	}
+}
+
+func TestVideosService_Edit_spatialInvalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Videos.Edit sent the request")
+	})
+
+	input := &VideoRequest{Spatial: &Spatial{Projection: "fisheye"}}
+	if _, _, err := client.Videos.Edit(context.Background(), 1, input); err == nil {
+		t.Errorf("Videos.Edit(%+v) expected error", input)
+	}
+}
+
 func TestVideosService_Delete(t *testing.T) {
 	setup()
 	defer teardown()
@@ -103,7 +587,7 @@ func TestVideosService_Delete(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Videos.Delete(1)
+	_, err := client.Videos.Delete(context.Background(), 1)
 	if err != nil {
 		t.Errorf("Videos.Delete returned unexpected error: %v", err)
 	}
@@ -125,7 +609,7 @@ func TestVideosService_ListCategory(t *testing.T) {
 	opt := &ListCategoryOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	categories, _, err := client.Videos.ListCategory(1, opt)
+	categories, _, err := client.Videos.ListCategory(context.Background(), 1, opt)
 	if err != nil {
 		t.Errorf("Videos.ListCategory returned unexpected error: %v", err)
 	}
@@ -136,6 +620,83 @@ func TestVideosService_ListCategory(t *testing.T) {
 	}
 }
 
+func TestVideosService_Engagement(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/likes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"per_page": "2",
+		})
+		fmt.Fprint(w, `{"total": 10, "data": [{"name": "Test"}]}`)
+	})
+
+	mux.HandleFunc("/videos/1/comments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"per_page": "1",
+			"fields":   "uri",
+		})
+		fmt.Fprint(w, `{"total": 3, "data": [{"text": "Test"}]}`)
+	})
+
+	engagement, err := client.Videos.Engagement(context.Background(), 1, 2)
+	if err != nil {
+		t.Errorf("Videos.Engagement returned unexpected error: %v", err)
+	}
+
+	want := &Engagement{Likes: 10, Comments: 3, RecentLikers: []*User{{Name: "Test"}}}
+	if !reflect.DeepEqual(engagement, want) {
+		t.Errorf("Videos.Engagement returned %+v, want %+v", engagement, want)
+	}
+}
+
+func TestVideosService_Stats(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/stats", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{
+			"start": "2017-01-01",
+			"end":   "2017-01-02",
+		})
+		fmt.Fprint(w, `{"data": [{"date": "2017-01-01", "plays": 3}, {"date": "2017-01-02", "plays": 5}]}`)
+	})
+
+	opt := &StatsOptions{Start: "2017-01-01", End: "2017-01-02"}
+	stats, _, err := client.Videos.Stats(context.Background(), 1, opt)
+	if err != nil {
+		t.Errorf("Videos.Stats returned unexpected error: %v", err)
+	}
+
+	want := []*DailyStats{{Date: "2017-01-01", Plays: 3}, {Date: "2017-01-02", Plays: 5}}
+	if !reflect.DeepEqual(stats, want) {
+		t.Errorf("Videos.Stats returned %+v, want %+v", stats, want)
+	}
+}
+
+func TestVideosService_Stats_unavailable(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/stats", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"error": "upgrade required"}`)
+	})
+
+	_, _, err := client.Videos.Stats(context.Background(), 1, nil)
+	if err == nil || !strings.Contains(err.Error(), "not available for this account") {
+		t.Errorf("Videos.Stats returned error %v, want stats unavailable error", err)
+	}
+
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Errorf("Videos.Stats error %v does not wrap *ErrorResponse", err)
+	}
+}
+
 func TestVideosService_ListComment(t *testing.T) {
 	setup()
 	defer teardown()
@@ -152,7 +713,7 @@ func TestVideosService_ListComment(t *testing.T) {
 	opt := &ListCommentOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	comments, _, err := client.Videos.ListComment(1, opt)
+	comments, _, err := client.Videos.ListComment(context.Background(), 1, opt)
 	if err != nil {
 		t.Errorf("Videos.ListComment returned unexpected error: %v", err)
 	}
@@ -163,6 +724,43 @@ func TestVideosService_ListComment(t *testing.T) {
 	}
 }
 
+func TestVideosService_MostRecentlyCommented(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"uri": "/videos/1"}, {"uri": "/videos/2"}, {"uri": "/videos/3"}]}`)
+	})
+
+	comments := map[string]string{
+		"/videos/1/comments": `{"data": [{"created_on": "2017-01-01T10:00:00+00:00"}]}`,
+		"/videos/2/comments": `{"data": []}`,
+		"/videos/3/comments": `{"data": [{"created_on": "2017-02-01T10:00:00+00:00"}]}`,
+	}
+	for path, body := range comments {
+		body := body
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			testFormValues(t, r, values{
+				"direction": "desc",
+				"per_page":  "1",
+			})
+			fmt.Fprint(w, body)
+		})
+	}
+
+	videos, err := client.Videos.MostRecentlyCommented(context.Background(), "1", 5)
+	if err != nil {
+		t.Errorf("Videos.MostRecentlyCommented returned unexpected error: %v", err)
+	}
+
+	want := []*Video{{URI: "/videos/3"}, {URI: "/videos/1"}}
+	if !reflect.DeepEqual(videos, want) {
+		t.Errorf("Videos.MostRecentlyCommented returned %+v, want %+v", videos, want)
+	}
+}
+
 func TestVideosService_GetComment(t *testing.T) {
 	setup()
 	defer teardown()
@@ -172,7 +770,7 @@ func TestVideosService_GetComment(t *testing.T) {
 		fmt.Fprint(w, `{"text": "Test"}`)
 	})
 
-	comment, _, err := client.Videos.GetComment(1, 1)
+	comment, _, err := client.Videos.GetComment(context.Background(), 1, 1)
 	if err != nil {
 		t.Errorf("Videos.GetComment returned unexpected error: %v", err)
 	}
@@ -203,7 +801,7 @@ func TestVideosService_AddComment(t *testing.T) {
 		fmt.Fprint(w, `{"text": "name"}`)
 	})
 
-	comment, _, err := client.Videos.AddComment(1, input)
+	comment, _, err := client.Videos.AddComment(context.Background(), 1, input)
 	if err != nil {
 		t.Errorf("Videos.AddComment returned unexpected error: %v", err)
 	}
@@ -234,7 +832,7 @@ func TestVideosService_EditComment(t *testing.T) {
 		fmt.Fprint(w, `{"text": "name"}`)
 	})
 
-	comment, _, err := client.Videos.EditComment(1, 1, input)
+	comment, _, err := client.Videos.EditComment(context.Background(), 1, 1, input)
 	if err != nil {
 		t.Errorf("Videos.EditComment returned unexpected error: %v", err)
 	}
@@ -253,7 +851,7 @@ func TestVideosService_DeleteComment(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Videos.DeleteComment(1, 1)
+	_, err := client.Videos.DeleteComment(context.Background(), 1, 1)
 	if err != nil {
 		t.Errorf("Videos.DeleteComment returned unexpected error: %v", err)
 	}
@@ -275,7 +873,7 @@ func TestVideosService_ListReplies(t *testing.T) {
 	opt := &ListRepliesOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	replies, _, err := client.Videos.ListReplies(1, 1, opt)
+	replies, _, err := client.Videos.ListReplies(context.Background(), 1, 1, opt)
 	if err != nil {
 		t.Errorf("Videos.ListReplies returned unexpected error: %v", err)
 	}
@@ -306,7 +904,7 @@ func TestVideosService_AddReplies(t *testing.T) {
 		fmt.Fprint(w, `{"text": "name"}`)
 	})
 
-	replies, _, err := client.Videos.AddReplies(1, 1, input)
+	replies, _, err := client.Videos.AddReplies(context.Background(), 1, 1, input)
 	if err != nil {
 		t.Errorf("Videos.AddReplies returned unexpected error: %v", err)
 	}
@@ -333,7 +931,7 @@ func TestVideosService_ListCredit(t *testing.T) {
 	opt := &ListCreditOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	credits, _, err := client.Videos.ListCredit(1, opt)
+	credits, _, err := client.Videos.ListCredit(context.Background(), 1, opt)
 	if err != nil {
 		t.Errorf("Videos.ListCredit returned unexpected error: %v", err)
 	}
@@ -353,7 +951,7 @@ func TestVideosService_GetCredit(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	credit, _, err := client.Videos.GetCredit(1, 1)
+	credit, _, err := client.Videos.GetCredit(context.Background(), 1, 1)
 	if err != nil {
 		t.Errorf("Videos.GetCredit returned unexpected error: %v", err)
 	}
@@ -364,6 +962,26 @@ func TestVideosService_GetCredit(t *testing.T) {
 	}
 }
 
+func TestVideosService_GetCredit_userAndVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/credits/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name": "Test", "user": {"name": "User"}, "video": {"name": "Video"}}`)
+	})
+
+	credit, _, err := client.Videos.GetCredit(context.Background(), 1, 1)
+	if err != nil {
+		t.Errorf("Videos.GetCredit returned unexpected error: %v", err)
+	}
+
+	want := &Credit{Name: "Test", User: &User{Name: "User"}, Video: &Video{Name: "Video"}}
+	if !reflect.DeepEqual(credit, want) {
+		t.Errorf("Videos.GetCredit returned %+v, want %+v", credit, want)
+	}
+}
+
 func TestVideosService_AddCredit(t *testing.T) {
 	setup()
 	defer teardown()
@@ -384,7 +1002,7 @@ func TestVideosService_AddCredit(t *testing.T) {
 		fmt.Fprint(w, `{"name": "name"}`)
 	})
 
-	credit, _, err := client.Videos.AddCredit(1, input)
+	credit, _, err := client.Videos.AddCredit(context.Background(), 1, input)
 	if err != nil {
 		t.Errorf("Videos.AddCredit returned unexpected error: %v", err)
 	}
@@ -415,7 +1033,7 @@ func TestVideosService_EditCredit(t *testing.T) {
 		fmt.Fprint(w, `{"name": "name"}`)
 	})
 
-	credit, _, err := client.Videos.EditCredit(1, 1, input)
+	credit, _, err := client.Videos.EditCredit(context.Background(), 1, 1, input)
 	if err != nil {
 		t.Errorf("Videos.EditCredit returned unexpected error: %v", err)
 	}
@@ -434,7 +1052,7 @@ func TestVideosService_DeleteCredit(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Videos.DeleteCredit(1, 1)
+	_, err := client.Videos.DeleteCredit(context.Background(), 1, 1)
 	if err != nil {
 		t.Errorf("Videos.DeleteCredit returned unexpected error: %v", err)
 	}
@@ -449,7 +1067,7 @@ func TestVideosService_ListPictures(t *testing.T) {
 		fmt.Fprint(w, `{"data": [{"uri": "Test"}]}`)
 	})
 
-	pictures, _, err := client.Videos.ListPictures(1)
+	pictures, _, err := client.Videos.ListPictures(context.Background(), 1)
 	if err != nil {
 		t.Errorf("Videos.ListPictures returned unexpected error: %v", err)
 	}
@@ -480,7 +1098,7 @@ func TestVideosService_CreatePictures(t *testing.T) {
 		fmt.Fprint(w, `{"uri": "name"}`)
 	})
 
-	pictures, _, err := client.Videos.CreatePictures(1, input)
+	pictures, _, err := client.Videos.CreatePictures(context.Background(), 1, input)
 	if err != nil {
 		t.Errorf("Videos.CreatePictures returned unexpected error: %v", err)
 	}
@@ -500,7 +1118,7 @@ func TestVideosService_GetPictures(t *testing.T) {
 		fmt.Fprint(w, `{"uri": "Test"}`)
 	})
 
-	pictures, _, err := client.Videos.GetPictures(1, 1)
+	pictures, _, err := client.Videos.GetPictures(context.Background(), 1, 1)
 	if err != nil {
 		t.Errorf("Videos.GetPictures returned unexpected error: %v", err)
 	}
@@ -531,7 +1149,7 @@ func TestVideosService_EditPictures(t *testing.T) {
 		fmt.Fprint(w, `{"uri": "name"}`)
 	})
 
-	pictures, _, err := client.Videos.EditPictures(1, 1, input)
+	pictures, _, err := client.Videos.EditPictures(context.Background(), 1, 1, input)
 	if err != nil {
 		t.Errorf("Videos.EditPictures returned unexpected error: %v", err)
 	}
@@ -550,12 +1168,200 @@ func TestVideosService_DeletePictures(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Videos.DeletePictures(1, 1)
+	_, err := client.Videos.DeletePictures(context.Background(), 1, 1)
 	if err != nil {
 		t.Errorf("Videos.DeletePictures returned unexpected error: %v", err)
 	}
 }
 
+func TestVideosService_SetThumbnail(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/pictures", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprintf(w, `{"uri": "/videos/1/pictures/2", "link": "%s/upload"}`, server.URL)
+	})
+
+	mux.HandleFunc("/upload", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != "image" {
+			t.Errorf("Videos.SetThumbnail uploaded %q, want %q", body, "image")
+		}
+	})
+
+	mux.HandleFunc("/videos/1/pictures/2", func(w http.ResponseWriter, r *http.Request) {
+		v := &PicturesRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PATCH")
+		if want := (&PicturesRequest{Active: true}); !reflect.DeepEqual(v, want) {
+			t.Errorf("Videos.SetThumbnail body is %+v, want %+v", v, want)
+		}
+
+		fmt.Fprint(w, `{"uri": "/videos/1/pictures/2", "active": true}`)
+	})
+
+	pictures, source, err := client.Videos.SetThumbnail(context.Background(), 1, strings.NewReader("image"), 5)
+	if err != nil {
+		t.Errorf("Videos.SetThumbnail returned unexpected error: %v", err)
+	}
+
+	if source != ThumbnailUploaded {
+		t.Errorf("Videos.SetThumbnail source is %v, want %v", source, ThumbnailUploaded)
+	}
+
+	want := &Pictures{URI: "/videos/1/pictures/2", Active: true, Link: fmt.Sprintf("%s/upload", server.URL)}
+	if !reflect.DeepEqual(pictures, want) {
+		t.Errorf("Videos.SetThumbnail returned %+v, want %+v", pictures, want)
+	}
+}
+
+func TestVideosService_SetThumbnail_frameFallback(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/pictures", func(w http.ResponseWriter, r *http.Request) {
+		v := &PicturesRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if v.Time == 0 {
+			fmt.Fprintf(w, `{"uri": "/videos/1/pictures/2", "link": "%s/upload"}`, server.URL)
+			return
+		}
+
+		if want := (&PicturesRequest{Time: 5, Active: true}); !reflect.DeepEqual(v, want) {
+			t.Errorf("Videos.SetThumbnail body is %+v, want %+v", v, want)
+		}
+		fmt.Fprint(w, `{"uri": "/videos/1/pictures/3", "active": true}`)
+	})
+
+	mux.HandleFunc("/upload", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Bad image", http.StatusBadRequest)
+	})
+
+	pictures, source, err := client.Videos.SetThumbnail(context.Background(), 1, strings.NewReader("image"), 5)
+	if err != nil {
+		t.Errorf("Videos.SetThumbnail returned unexpected error: %v", err)
+	}
+
+	if source != ThumbnailFrame {
+		t.Errorf("Videos.SetThumbnail source is %v, want %v", source, ThumbnailFrame)
+	}
+
+	want := &Pictures{URI: "/videos/1/pictures/3", Active: true}
+	if !reflect.DeepEqual(pictures, want) {
+		t.Errorf("Videos.SetThumbnail returned %+v, want %+v", pictures, want)
+	}
+}
+
+func TestVideosService_GenerateThumbnail(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"fields": "duration"})
+		fmt.Fprint(w, `{"duration": 60}`)
+	})
+
+	mux.HandleFunc("/videos/1/pictures", func(w http.ResponseWriter, r *http.Request) {
+		v := &PicturesRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if want := (&PicturesRequest{Time: 12.5, Active: true}); !reflect.DeepEqual(v, want) {
+			t.Errorf("Videos.GenerateThumbnail body is %+v, want %+v", v, want)
+		}
+
+		fmt.Fprint(w, `{"uri": "/videos/1/pictures/2", "active": true}`)
+	})
+
+	pictures, _, err := client.Videos.GenerateThumbnail(context.Background(), 1, 12.5)
+	if err != nil {
+		t.Errorf("Videos.GenerateThumbnail returned unexpected error: %v", err)
+	}
+
+	want := &Pictures{URI: "/videos/1/pictures/2", Active: true}
+	if !reflect.DeepEqual(pictures, want) {
+		t.Errorf("Videos.GenerateThumbnail returned %+v, want %+v", pictures, want)
+	}
+}
+
+func TestVideosService_GenerateThumbnail_firstFrame(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"duration": 60}`)
+	})
+
+	mux.HandleFunc("/videos/1/pictures", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if got, want := strings.TrimSpace(string(body)), `{"time":0,"active":true}`; got != want {
+			t.Errorf("Videos.GenerateThumbnail body is %s, want %s", got, want)
+		}
+
+		fmt.Fprint(w, `{"uri": "/videos/1/pictures/2", "active": true}`)
+	})
+
+	if _, _, err := client.Videos.GenerateThumbnail(context.Background(), 1, 0); err != nil {
+		t.Errorf("Videos.GenerateThumbnail returned unexpected error: %v", err)
+	}
+}
+
+func TestVideosService_GenerateThumbnail_outOfDuration(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"duration": 60}`)
+	})
+
+	mux.HandleFunc("/videos/1/pictures", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Videos.GenerateThumbnail created a picture out of the duration")
+	})
+
+	if _, _, err := client.Videos.GenerateThumbnail(context.Background(), 1, 61); err == nil {
+		t.Error("Videos.GenerateThumbnail expected error")
+	}
+}
+
+func TestVideosService_UploadThumbnail(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/pictures", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprintf(w, `{"uri": "/videos/1/pictures/2", "link": "%s/upload"}`, server.URL)
+	})
+
+	mux.HandleFunc("/upload", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != "image" {
+			t.Errorf("Videos.UploadThumbnail uploaded %q, want %q", body, "image")
+		}
+	})
+
+	mux.HandleFunc("/videos/1/pictures/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		fmt.Fprint(w, `{"uri": "/videos/1/pictures/2", "active": true}`)
+	})
+
+	pictures, _, err := client.Videos.UploadThumbnail(context.Background(), 1, strings.NewReader("image"))
+	if err != nil {
+		t.Errorf("Videos.UploadThumbnail returned unexpected error: %v", err)
+	}
+
+	want := &Pictures{URI: "/videos/1/pictures/2", Active: true, Link: fmt.Sprintf("%s/upload", server.URL)}
+	if !reflect.DeepEqual(pictures, want) {
+		t.Errorf("Videos.UploadThumbnail returned %+v, want %+v", pictures, want)
+	}
+}
+
 func TestVideosService_GetPreset(t *testing.T) {
 	setup()
 	defer teardown()
@@ -565,7 +1371,7 @@ func TestVideosService_GetPreset(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	preset, _, err := client.Videos.GetPreset(1, 1)
+	preset, _, err := client.Videos.GetPreset(context.Background(), 1, 1)
 	if err != nil {
 		t.Errorf("Videos.GetPreset returned unexpected error: %v", err)
 	}
@@ -576,79 +1382,255 @@ func TestVideosService_GetPreset(t *testing.T) {
 	}
 }
 
-func TestVideosService_AssignPreset(t *testing.T) {
+func TestVideosService_AssignPreset(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/presets/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+	})
+
+	_, err := client.Videos.AssignPreset(context.Background(), 1, 1)
+	if err != nil {
+		t.Errorf("Videos.AssignPreset returned unexpected error: %v", err)
+	}
+}
+
+func TestVideosService_UnassignPreset(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/presets/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Videos.UnassignPreset(context.Background(), 1, 1)
+	if err != nil {
+		t.Errorf("Videos.UnassignPreset returned unexpected error: %v", err)
+	}
+}
+
+func TestVideosService_ListDomain(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/privacy/domains", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"uri": "Test"}]}`)
+	})
+
+	domains, _, err := client.Videos.ListDomain(context.Background(), 1)
+	if err != nil {
+		t.Errorf("Videos.ListDomain returned unexpected error: %v", err)
+	}
+
+	want := []*Domain{{URI: "Test"}}
+	if !reflect.DeepEqual(domains, want) {
+		t.Errorf("Videos.ListDomain returned %+v, want %+v", domains, want)
+	}
+}
+
+func TestVideosService_AllowDomain(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/privacy/domains/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+	})
+
+	_, err := client.Videos.AllowDomain(context.Background(), 1, "1")
+	if err != nil {
+		t.Errorf("Videos.AllowDomain returned unexpected error: %v", err)
+	}
+}
+
+func TestVideosService_DisallowDomain(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/privacy/domains/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Videos.DisallowDomain(context.Background(), 1, "1")
+	if err != nil {
+		t.Errorf("Videos.DisallowDomain returned unexpected error: %v", err)
+	}
+}
+
+func TestVideosService_ListEmbedDomains(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/privacy/domains", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			fmt.Fprint(w, `{"data": [{"domain": "example.com"}], "paging": {"next": "/videos/1/privacy/domains?page=2"}}`)
+		case "2":
+			fmt.Fprint(w, `{"data": [{"domain": "example.org"}], "paging": {"next": null}}`)
+		}
+	})
+
+	domains, _, err := client.Videos.ListEmbedDomains(context.Background(), 1)
+	if err != nil {
+		t.Errorf("Videos.ListEmbedDomains returned unexpected error: %v", err)
+	}
+
+	if want := []string{"example.com", "example.org"}; !reflect.DeepEqual(domains, want) {
+		t.Errorf("Videos.ListEmbedDomains returned %v, want %v", domains, want)
+	}
+}
+
+func TestVideosService_ListEmbedDomains_baseURLPath(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.BaseURL, _ = url.Parse(server.URL + "/api/")
+
+	mux.HandleFunc("/api/videos/1/privacy/domains", func(w http.ResponseWriter, r *http.Request) {
+		switch r.FormValue("page") {
+		case "":
+			fmt.Fprint(w, `{"data": [{"domain": "example.com"}], "paging": {"next": "/videos/1/privacy/domains?page=2"}}`)
+		case "2":
+			fmt.Fprint(w, `{"data": [{"domain": "example.org"}], "paging": {"next": null}}`)
+		}
+	})
+
+	domains, _, err := client.Videos.ListEmbedDomains(context.Background(), 1)
+	if err != nil {
+		t.Errorf("Videos.ListEmbedDomains returned unexpected error: %v", err)
+	}
+
+	if want := []string{"example.com", "example.org"}; !reflect.DeepEqual(domains, want) {
+		t.Errorf("Videos.ListEmbedDomains returned %v, want %v", domains, want)
+	}
+}
+
+func TestVideosService_SetEmbedDomains(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/videos/1/presets/1", func(w http.ResponseWriter, r *http.Request) {
-		testMethod(t, r, "PUT")
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{"fields": "privacy.embed"})
+		fmt.Fprint(w, `{"privacy": {"embed": "whitelist"}}`)
+	})
+
+	mux.HandleFunc("/videos/1/privacy/domains", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": [{"domain": "example.com"}, {"domain": "example.net"}]}`)
 	})
 
-	_, err := client.Videos.AssignPreset(1, 1)
+	var got []string
+	mux.HandleFunc("/videos/1/privacy/domains/", func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Method+" "+strings.TrimPrefix(r.URL.Path, "/videos/1/privacy/domains/"))
+	})
+
+	_, err := client.Videos.SetEmbedDomains(context.Background(), 1, []string{"example.com", "example.org"})
 	if err != nil {
-		t.Errorf("Videos.AssignPreset returned unexpected error: %v", err)
+		t.Errorf("Videos.SetEmbedDomains returned unexpected error: %v", err)
+	}
+
+	if want := []string{"DELETE example.net", "PUT example.org"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Videos.SetEmbedDomains made %v, want %v", got, want)
 	}
 }
 
-func TestVideosService_UnassignPreset(t *testing.T) {
+func TestVideosService_SetEmbedDomains_notWhitelist(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/videos/1/presets/1", func(w http.ResponseWriter, r *http.Request) {
-		testMethod(t, r, "DELETE")
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"privacy": {"embed": "public"}}`)
 	})
 
-	_, err := client.Videos.UnassignPreset(1, 1)
-	if err != nil {
-		t.Errorf("Videos.UnassignPreset returned unexpected error: %v", err)
+	mux.HandleFunc("/videos/1/privacy/domains/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Videos.SetEmbedDomains made %v %v", r.Method, r.URL.Path)
+	})
+
+	if _, err := client.Videos.SetEmbedDomains(context.Background(), 1, []string{"example.com"}); err == nil {
+		t.Error("Videos.SetEmbedDomains expected error")
 	}
 }
 
-func TestVideosService_ListDomain(t *testing.T) {
+func TestVideosService_RemoveEmbedDomains(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/videos/1/privacy/domains", func(w http.ResponseWriter, r *http.Request) {
-		testMethod(t, r, "GET")
-		fmt.Fprint(w, `{"data": [{"uri": "Test"}]}`)
+	var got []string
+	mux.HandleFunc("/videos/1/privacy/domains/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		got = append(got, strings.TrimPrefix(r.URL.Path, "/videos/1/privacy/domains/"))
 	})
 
-	domains, _, err := client.Videos.ListDomain(1)
+	_, err := client.Videos.RemoveEmbedDomains(context.Background(), 1, []string{"example.com", "example.org"})
 	if err != nil {
-		t.Errorf("Videos.ListDomain returned unexpected error: %v", err)
+		t.Errorf("Videos.RemoveEmbedDomains returned unexpected error: %v", err)
 	}
 
-	want := []*Domain{{URI: "Test"}}
-	if !reflect.DeepEqual(domains, want) {
-		t.Errorf("Videos.ListDomain returned %+v, want %+v", domains, want)
+	if want := []string{"example.com", "example.org"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Videos.RemoveEmbedDomains disallowed %v, want %v", got, want)
 	}
 }
 
-func TestVideosService_AllowDomain(t *testing.T) {
+func TestVideosService_IsEmbeddableOn(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/videos/1/privacy/domains/1", func(w http.ResponseWriter, r *http.Request) {
-		testMethod(t, r, "PUT")
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"fields": "privacy.embed"})
+		fmt.Fprint(w, `{"privacy": {"embed": "whitelist"}}`)
 	})
 
-	_, err := client.Videos.AllowDomain(1, "1")
-	if err != nil {
-		t.Errorf("Videos.AllowDomain returned unexpected error: %v", err)
+	mux.HandleFunc("/videos/1/privacy/domains", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.FormValue("page") {
+		case "":
+			fmt.Fprint(w, `{"data": [{"domain": "example.com"}], "paging": {"next": "/videos/1/privacy/domains?page=2"}}`)
+		case "2":
+			fmt.Fprint(w, `{"data": [{"domain": "*.partner.org"}], "paging": {"next": null}}`)
+		}
+	})
+
+	tests := []struct {
+		domain string
+		want   bool
+	}{
+		{"example.com", true},
+		{"https://Example.com/page", true},
+		{"www.partner.org", true},
+		{"partner.com", false},
+		{"sub.example.com", false},
+	}
+
+	for _, tt := range tests {
+		got, err := client.Videos.IsEmbeddableOn(context.Background(), 1, tt.domain)
+		if err != nil {
+			t.Errorf("Videos.IsEmbeddableOn returned unexpected error: %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("Videos.IsEmbeddableOn(%q) returned %v, want %v", tt.domain, got, tt.want)
+		}
 	}
 }
 
-func TestVideosService_DisallowDomain(t *testing.T) {
+func TestVideosService_IsEmbeddableOn_privacy(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/videos/1/privacy/domains/1", func(w http.ResponseWriter, r *http.Request) {
-		testMethod(t, r, "DELETE")
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"privacy": {"embed": "public"}}`)
 	})
 
-	_, err := client.Videos.DisallowDomain(1, "1")
-	if err != nil {
-		t.Errorf("Videos.DisallowDomain returned unexpected error: %v", err)
+	mux.HandleFunc("/videos/2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"privacy": {"embed": "private"}}`)
+	})
+
+	if ok, _ := client.Videos.IsEmbeddableOn(context.Background(), 1, "example.com"); !ok {
+		t.Error("Videos.IsEmbeddableOn returned false for public embed")
+	}
+	if ok, _ := client.Videos.IsEmbeddableOn(context.Background(), 2, "example.com"); ok {
+		t.Error("Videos.IsEmbeddableOn returned true for private embed")
 	}
 }
 
@@ -661,7 +1643,7 @@ func TestVideosService_ListUser(t *testing.T) {
 		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
 	})
 
-	users, _, err := client.Videos.ListUser(1)
+	users, _, err := client.Videos.ListUser(context.Background(), 1)
 	if err != nil {
 		t.Errorf("Videos.ListUser returned unexpected error: %v", err)
 	}
@@ -680,7 +1662,7 @@ func TestVideosService_AllowUsers(t *testing.T) {
 		testMethod(t, r, "PUT")
 	})
 
-	_, err := client.Videos.AllowUsers(1)
+	_, err := client.Videos.AllowUsers(context.Background(), 1)
 	if err != nil {
 		t.Errorf("Videos.AllowUsers returned unexpected error: %v", err)
 	}
@@ -694,7 +1676,7 @@ func TestVideosService_AllowUser(t *testing.T) {
 		testMethod(t, r, "PUT")
 	})
 
-	_, err := client.Videos.AllowUser(1, "1")
+	_, err := client.Videos.AllowUser(context.Background(), 1, "1")
 	if err != nil {
 		t.Errorf("Videos.AllowDomain returned unexpected error: %v", err)
 	}
@@ -708,7 +1690,7 @@ func TestVideosService_DisallowUser(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Videos.DisallowUser(1, "1")
+	_, err := client.Videos.DisallowUser(context.Background(), 1, "1")
 	if err != nil {
 		t.Errorf("Videos.DisallowUser returned unexpected error: %v", err)
 	}
@@ -723,7 +1705,7 @@ func TestVideosService_ListTag(t *testing.T) {
 		fmt.Fprint(w, `{"data": [{"uri": "Test"}]}`)
 	})
 
-	tags, _, err := client.Videos.ListTag(1)
+	tags, _, err := client.Videos.ListTag(context.Background(), 1)
 	if err != nil {
 		t.Errorf("Videos.ListTag returned unexpected error: %v", err)
 	}
@@ -743,7 +1725,7 @@ func TestVideosService_GetTag(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	tag, _, err := client.Videos.GetTag(1, "1")
+	tag, _, err := client.Videos.GetTag(context.Background(), 1, "1")
 	if err != nil {
 		t.Errorf("Videos.GetTag returned unexpected error: %v", err)
 	}
@@ -762,7 +1744,24 @@ func TestVideosService_AssignTag(t *testing.T) {
 		testMethod(t, r, "PUT")
 	})
 
-	_, err := client.Videos.AssignTag(1, "1")
+	_, err := client.Videos.AssignTag(context.Background(), 1, "1")
+	if err != nil {
+		t.Errorf("Videos.AssignTag returned unexpected error: %v", err)
+	}
+}
+
+func TestVideosService_AssignTag_escaped(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/tags/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		if got, want := r.URL.EscapedPath(), "/videos/1/tags/c%23%2Fgo"; got != want {
+			t.Errorf("Videos.AssignTag requested %q, want %q", got, want)
+		}
+	})
+
+	_, err := client.Videos.AssignTag(context.Background(), 1, "c#/go")
 	if err != nil {
 		t.Errorf("Videos.AssignTag returned unexpected error: %v", err)
 	}
@@ -776,12 +1775,83 @@ func TestVideosService_UnassignTag(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Videos.UnassignTag(1, "1")
+	_, err := client.Videos.UnassignTag(context.Background(), 1, "1")
 	if err != nil {
 		t.Errorf("Videos.UnassignTag returned unexpected error: %v", err)
 	}
 }
 
+func TestVideosService_AssignTags(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/tags", func(w http.ResponseWriter, r *http.Request) {
+		var v []*tagRequest
+		json.NewDecoder(r.Body).Decode(&v)
+
+		testMethod(t, r, "PUT")
+		want := []*tagRequest{{Name: "a"}, {Name: "b"}}
+		if !reflect.DeepEqual(v, want) {
+			t.Errorf("Videos.AssignTags body is %+v, want %+v", v, want)
+		}
+	})
+
+	_, err := client.Videos.AssignTags(context.Background(), 1, []string{"a", "b"})
+	if err != nil {
+		t.Errorf("Videos.AssignTags returned unexpected error: %v", err)
+	}
+}
+
+func TestVideosService_AddTagsToMany(t *testing.T) {
+	setup()
+	defer teardown()
+
+	// The default RetryMax doesn't retry, AddTagsToMany still does on 429.
+	client.RetryWaitMin = time.Millisecond
+
+	var mu sync.Mutex
+	calls := make(map[string]int)
+	mux.HandleFunc("/videos/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+
+		mu.Lock()
+		calls[r.URL.Path]++
+		n := calls[r.URL.Path]
+		mu.Unlock()
+
+		switch r.URL.Path {
+		case "/videos/2/tags":
+			if n == 1 {
+				w.WriteHeader(http.StatusTooManyRequests)
+			}
+		case "/videos/3/tags":
+			http.Error(w, "Not found", http.StatusNotFound)
+		}
+	})
+
+	errs, err := client.Videos.AddTagsToMany(context.Background(), []int{1, 2, 3}, []string{"a"})
+	if err == nil {
+		t.Error("Videos.AddTagsToMany expected error")
+	}
+
+	if len(errs) != 3 || errs[0] != nil || errs[1] != nil || errs[2] == nil {
+		t.Errorf("Videos.AddTagsToMany returned errors %v", errs)
+	}
+
+	if n := calls["/videos/2/tags"]; n != 2 {
+		t.Errorf("Videos.AddTagsToMany requested rate limited video %d times, want 2", n)
+	}
+}
+
+func TestVideosService_AddTagsToMany_noTags(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if _, err := client.Videos.AddTagsToMany(context.Background(), []int{1}, nil); err == nil {
+		t.Error("Videos.AddTagsToMany expected error")
+	}
+}
+
 func TestVideosService_ListTextTrack(t *testing.T) {
 	setup()
 	defer teardown()
@@ -791,7 +1861,7 @@ func TestVideosService_ListTextTrack(t *testing.T) {
 		fmt.Fprint(w, `{"data": [{"name": "Test"}]}`)
 	})
 
-	textTrack, _, err := client.Videos.ListTextTrack(1)
+	textTrack, _, err := client.Videos.ListTextTrack(context.Background(), 1)
 	if err != nil {
 		t.Errorf("Videos.ListTextTrack returned unexpected error: %v", err)
 	}
@@ -822,7 +1892,7 @@ func TestVideosService_AddTextTrack(t *testing.T) {
 		fmt.Fprint(w, `{"name": "name"}`)
 	})
 
-	textTrack, _, err := client.Videos.AddTextTrack(1, input)
+	textTrack, _, err := client.Videos.AddTextTrack(context.Background(), 1, input)
 	if err != nil {
 		t.Errorf("Videos.AddTextTrack returned unexpected error: %v", err)
 	}
@@ -833,6 +1903,62 @@ func TestVideosService_AddTextTrack(t *testing.T) {
 	}
 }
 
+func TestVideosService_UploadTextTrack(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &TextTrackRequest{
+		Active:   true,
+		Type:     "captions",
+		Language: "en",
+		Name:     "name",
+	}
+
+	mux.HandleFunc("/videos/1/texttracks", func(w http.ResponseWriter, r *http.Request) {
+		v := &TextTrackRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Videos.UploadTextTrack body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprintf(w, `{"uri": "/videos/1/texttracks/2", "type": "captions", "language": "en", "link": "%s/upload/2"}`, server.URL)
+	})
+
+	mux.HandleFunc("/upload/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Content-Type", "text/vtt")
+		body, _ := ioutil.ReadAll(r.Body)
+		if got, want := string(body), "WEBVTT\n"; got != want {
+			t.Errorf("Videos.UploadTextTrack uploaded %q, want %q", got, want)
+		}
+	})
+
+	textTrack, _, err := client.Videos.UploadTextTrack(context.Background(), 1, strings.NewReader("WEBVTT\n"), input)
+	if err != nil {
+		t.Errorf("Videos.UploadTextTrack returned unexpected error: %v", err)
+	}
+
+	want := &TextTrack{URI: "/videos/1/texttracks/2", Type: "captions", Language: "en", Link: server.URL + "/upload/2"}
+	if !reflect.DeepEqual(textTrack, want) {
+		t.Errorf("Videos.UploadTextTrack returned %+v, want %+v", textTrack, want)
+	}
+}
+
+func TestVideosService_UploadTextTrack_noLink(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/texttracks", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"uri": "/videos/1/texttracks/2"}`)
+	})
+
+	if _, _, err := client.Videos.UploadTextTrack(context.Background(), 1, strings.NewReader("WEBVTT\n"), &TextTrackRequest{}); err == nil {
+		t.Error("Videos.UploadTextTrack expected error")
+	}
+}
+
 func TestVideosService_GetTextTrack(t *testing.T) {
 	setup()
 	defer teardown()
@@ -842,7 +1968,7 @@ func TestVideosService_GetTextTrack(t *testing.T) {
 		fmt.Fprint(w, `{"uri": "Test"}`)
 	})
 
-	textTrack, _, err := client.Videos.GetTextTrack(1, 1)
+	textTrack, _, err := client.Videos.GetTextTrack(context.Background(), 1, 1)
 	if err != nil {
 		t.Errorf("Videos.GetTextTrack returned unexpected error: %v", err)
 	}
@@ -873,7 +1999,7 @@ func TestVideosService_EditTextTrack(t *testing.T) {
 		fmt.Fprint(w, `{"name": "name"}`)
 	})
 
-	textTrack, _, err := client.Videos.EditTextTrack(1, 1, input)
+	textTrack, _, err := client.Videos.EditTextTrack(context.Background(), 1, 1, input)
 	if err != nil {
 		t.Errorf("Videos.EditTextTrack returned unexpected error: %v", err)
 	}
@@ -892,7 +2018,7 @@ func TestVideosService_DeleteTextTrack(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Videos.DeleteTextTrack(1, 1)
+	_, err := client.Videos.DeleteTextTrack(context.Background(), 1, 1)
 	if err != nil {
 		t.Errorf("Videos.DeleteTextTrack returned unexpected error: %v", err)
 	}
@@ -905,6 +2031,7 @@ func TestVideosService_ListRelatedVideo(t *testing.T) {
 	mux.HandleFunc("/videos/1/videos", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
 		testFormValues(t, r, values{
+			"filter":   "related",
 			"page":     "1",
 			"per_page": "2",
 		})
@@ -914,7 +2041,7 @@ func TestVideosService_ListRelatedVideo(t *testing.T) {
 	opt := &ListVideoOptions{
 		ListOptions: ListOptions{Page: 1, PerPage: 2},
 	}
-	videos, _, err := client.Videos.ListRelatedVideo(1, opt)
+	videos, _, err := client.Videos.ListRelatedVideo(context.Background(), 1, opt)
 	if err != nil {
 		t.Errorf("Videos.ListRelatedVideo returned unexpected error: %v", err)
 	}
@@ -943,7 +2070,7 @@ func TestVideosService_getUploadVideo(t *testing.T) {
 		fmt.Fprint(w, `{"ticket_id": "1"}`)
 	})
 
-	uploadVideo, _, err := getUploadVideo(client, "/me/videos", input)
+	uploadVideo, _, err := getUploadVideo(context.Background(), client, "/me/videos", input)
 	if err != nil {
 		t.Errorf("Videos.getUploadVideo returned unexpected error: %v", err)
 	}
@@ -968,7 +2095,7 @@ func TestVideosService_completeUploadVideo(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	video, _, err := completeUploadVideo(client, "/users/1/tickets/1")
+	video, _, err := completeUploadVideo(context.Background(), client, "/users/1/tickets/1")
 	if err != nil {
 		t.Errorf("Videos.completeUploadVideo returned unexpected error: %v", err)
 	}
@@ -988,7 +2115,7 @@ func TestVideosService_processUploadVideo(t *testing.T) {
 		w.Header().Add("Range", "bytes=0-1000")
 	})
 
-	lastByte, err := processUploadVideo(client, fmt.Sprintf("%s/%s", client.BaseURL.String(), "upload"))
+	lastByte, err := processUploadVideo(context.Background(), client, fmt.Sprintf("%s/%s", client.BaseURL.String(), "upload"))
 	if err != nil {
 		t.Errorf("Videos.processUploadVideo returned unexpected error: %v", err)
 	}
@@ -1018,7 +2145,7 @@ func TestVideosService_uploadVideoByURL(t *testing.T) {
 		fmt.Fprint(w, `{"name": "Test"}`)
 	})
 
-	video, _, err := uploadVideoByURL(client, "/me/videos", videoURL)
+	video, _, err := uploadVideoByURL(context.Background(), client, "/me/videos", videoURL)
 	if err != nil {
 		t.Errorf("Videos.Get returned unexpected error: %v", err)
 	}
@@ -1028,3 +2155,216 @@ func TestVideosService_uploadVideoByURL(t *testing.T) {
 		t.Errorf("Videos.Get returned %+v, want %+v", video, want)
 	}
 }
+
+func TestVideosService_ReplaceSource(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1/versions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		body, _ := ioutil.ReadAll(r.Body)
+		if want := `{"upload":{"approach":"tus","size":10}}` + "\n"; string(body) != want {
+			t.Errorf("Videos.ReplaceSource body is %q, want %q", body, want)
+		}
+		fmt.Fprintf(w, `{"uri": "/videos/1/versions/2", "upload": {"upload_link": "%s/tus"}}`, server.URL)
+	})
+
+	var uploaded bytes.Buffer
+	mux.HandleFunc("/tus", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testHeader(t, r, "Upload-Offset", "0")
+		n, _ := io.Copy(&uploaded, r.Body)
+		w.Header().Set("Upload-Offset", fmt.Sprint(n))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"uri": "/videos/1", "name": "Test"}`)
+	})
+
+	// Not an io.ReaderAt, the file is read sequentially.
+	file := io.MultiReader(strings.NewReader("0123456789"))
+	video, _, err := client.Videos.ReplaceSource(context.Background(), 1, file, 10)
+	if err != nil {
+		t.Fatalf("Videos.ReplaceSource returned unexpected error: %v", err)
+	}
+
+	if got := uploaded.String(); got != "0123456789" {
+		t.Errorf("Videos.ReplaceSource uploaded %q, want %q", got, "0123456789")
+	}
+
+	want := &Video{URI: "/videos/1", Name: "Test"}
+	if !reflect.DeepEqual(video, want) {
+		t.Errorf("Videos.ReplaceSource returned %+v, want %+v", video, want)
+	}
+}
+
+func TestVideosService_WaitForTranscode(t *testing.T) {
+	setup()
+	defer teardown()
+
+	polls := 0
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		polls++
+		if polls < 3 {
+			fmt.Fprint(w, `{"uri": "/videos/1", "upload": {"status": "complete"}, "transcode": {"status": "in_progress"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"uri": "/videos/1", "upload": {"status": "complete"}, "transcode": {"status": "complete"}}`)
+	})
+
+	video, err := client.Videos.WaitForTranscode(context.Background(), 1, time.Millisecond)
+	if err != nil {
+		t.Fatalf("Videos.WaitForTranscode returned unexpected error: %v", err)
+	}
+
+	want := &Video{URI: "/videos/1", Upload: &VideoUpload{Status: "complete"}, Transcode: &VideoTranscode{Status: "complete"}}
+	if !reflect.DeepEqual(video, want) || polls != 3 {
+		t.Errorf("Videos.WaitForTranscode returned %+v after %d polls, want %+v after 3", video, polls, want)
+	}
+}
+
+func TestVideosService_WaitForTranscode_defaultInterval(t *testing.T) {
+	setup()
+	defer teardown()
+
+	polls := 0
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		fmt.Fprint(w, `{"uri": "/videos/1", "upload": {"status": "complete"}, "transcode": {"status": "in_progress"}}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := client.Videos.WaitForTranscode(ctx, 1, 0); err != context.DeadlineExceeded {
+		t.Errorf("Videos.WaitForTranscode returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	if polls != 1 {
+		t.Errorf("Videos.WaitForTranscode polled %d times in 50ms with the default interval, want 1", polls)
+	}
+}
+
+func TestVideosService_WaitForTranscode_error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"uri": "/videos/1", "transcode": {"status": "error"}}`)
+	})
+
+	if _, err := client.Videos.WaitForTranscode(context.Background(), 1, time.Millisecond); err == nil {
+		t.Error("Videos.WaitForTranscode expected error to be returned")
+	}
+}
+
+func TestVideosService_WaitForTranscode_contextCanceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		fmt.Fprint(w, `{"uri": "/videos/1", "transcode": {"status": "in_progress"}}`)
+	})
+
+	if _, err := client.Videos.WaitForTranscode(ctx, 1, time.Hour); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled error, got %v", err)
+	}
+}
+
+func TestVideosService_EmbedCode(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"fields": "embed.html"})
+		fmt.Fprint(w, `{"embed": {"html": "<iframe src=\"https://player.vimeo.com/video/1\"></iframe>"}}`)
+	})
+
+	code, _, err := client.Videos.EmbedCode(context.Background(), 1)
+	if err != nil {
+		t.Errorf("Videos.EmbedCode returned unexpected error: %v", err)
+	}
+
+	if want := `<iframe src="https://player.vimeo.com/video/1"></iframe>`; code != want {
+		t.Errorf("Videos.EmbedCode returned %q, want %q", code, want)
+	}
+}
+
+func TestVideosService_EmbedCode_forbidden(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/1", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"error": "You don't have permission to access this video."}`, http.StatusForbidden)
+	})
+
+	_, _, err := client.Videos.EmbedCode(context.Background(), 1)
+	if err == nil || !strings.Contains(err.Error(), "can't be embedded") {
+		t.Errorf("Videos.EmbedCode returned error %v, want an embedding error", err)
+	}
+
+	if !errors.Is(err, ErrForbidden) {
+		t.Errorf("Videos.EmbedCode returned error %v, want ErrForbidden", err)
+	}
+}
+
+func TestVideosService_BulkEdit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var changes []*VideoBulkChange
+	for i := 1; i <= maxBulkEdit+1; i++ {
+		changes = append(changes, &VideoBulkChange{
+			URI:     fmt.Sprintf("/videos/%d", i),
+			Privacy: &PrivacyRequest{View: "nobody"},
+		})
+	}
+
+	var batches []int
+	mux.HandleFunc("/me/videos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		var v []map[string]json.RawMessage
+		json.NewDecoder(r.Body).Decode(&v)
+		batches = append(batches, len(v))
+
+		if got, want := string(v[0]["privacy"]), `{"view":"nobody"}`; got != want {
+			t.Errorf("Videos.BulkEdit privacy is %s, want %s", got, want)
+		}
+
+		if len(v) == 1 {
+			http.Error(w, `{"error": "invalid video"}`, http.StatusBadRequest)
+		}
+	})
+
+	_, err := client.Videos.BulkEdit(context.Background(), changes)
+
+	if want := []int{maxBulkEdit, 1}; !reflect.DeepEqual(batches, want) {
+		t.Errorf("Videos.BulkEdit sent batches of %v, want %v", batches, want)
+	}
+
+	var bulkErr *BulkEditError
+	if !errors.As(err, &bulkErr) {
+		t.Fatalf("Videos.BulkEdit returned error %v, want a *BulkEditError", err)
+	}
+
+	uri := fmt.Sprintf("/videos/%d", maxBulkEdit+1)
+	if len(bulkErr.Failed) != 1 || bulkErr.Failed[uri] == nil {
+		t.Errorf("Videos.BulkEdit failed videos are %v, want only %s", bulkErr.Failed, uri)
+	}
+}
+
+func TestVideosService_BulkEdit_missingURI(t *testing.T) {
+	setup()
+	defer teardown()
+
+	if _, err := client.Videos.BulkEdit(context.Background(), []*VideoBulkChange{{Name: "n"}}); err == nil {
+		t.Error("Videos.BulkEdit expected error to be returned")
+	}
+}
@@ -1,6 +1,12 @@
 package vimeo
 
-import "fmt"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
 
 type dataListTextTrack struct {
 	Data []*TextTrack `json:"data,omitempty"`
@@ -8,15 +14,20 @@ type dataListTextTrack struct {
 }
 
 // TextTrack represents a text track.
-// TODO: Need full object.
+// Type is the kind of the track, e.g. "subtitles" or "captions".
 type TextTrack struct {
-	URI  string `json:"uri,omitempty"`
-	Name string `json:"name,omitempty"`
+	URI      string `json:"uri,omitempty"`
+	Active   bool   `json:"active,omitempty"`
+	Type     string `json:"type,omitempty"`
+	Language string `json:"language,omitempty"`
+	Link     string `json:"link,omitempty"`
+	HLSLink  string `json:"hls_link,omitempty"`
+	Name     string `json:"name,omitempty"`
 }
 
 // TextTrackRequest represents a request to create/edit text track.
 type TextTrackRequest struct {
-	Active   bool   `json:"role"`
+	Active   bool   `json:"active"`
 	Type     string `json:"type,omitempty"`
 	Language string `json:"language,omitempty"`
 	Name     string `json:"name,omitempty"`
@@ -25,8 +36,8 @@ type TextTrackRequest struct {
 // ListTextTrack lists the text tracks.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/texttracks
-func (s *VideosService) ListTextTrack(vid int) ([]*TextTrack, *Response, error) {
-	u := fmt.Sprintf("/videos/%d/texttracks", vid)
+func (s *VideosService) ListTextTrack(ctx context.Context, vid int) ([]*TextTrack, *Response, error) {
+	u := fmt.Sprintf("videos/%d/texttracks", vid)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
 		return nil, nil, err
@@ -34,7 +45,7 @@ func (s *VideosService) ListTextTrack(vid int) ([]*TextTrack, *Response, error)
 
 	texttracks := &dataListTextTrack{}
 
-	resp, err := s.client.Do(req, texttracks)
+	resp, err := s.client.Do(ctx, req, texttracks)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -47,8 +58,8 @@ func (s *VideosService) ListTextTrack(vid int) ([]*TextTrack, *Response, error)
 // AddTextTrack add text tracks.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/texttracks
-func (s *VideosService) AddTextTrack(vid int, r *TextTrackRequest) (*TextTrack, *Response, error) {
-	u := fmt.Sprintf("/videos/%d/texttracks", vid)
+func (s *VideosService) AddTextTrack(ctx context.Context, vid int, r *TextTrackRequest) (*TextTrack, *Response, error) {
+	u := fmt.Sprintf("videos/%d/texttracks", vid)
 	req, err := s.client.NewRequest("POST", u, r)
 	if err != nil {
 		return nil, nil, err
@@ -56,7 +67,34 @@ func (s *VideosService) AddTextTrack(vid int, r *TextTrackRequest) (*TextTrack,
 
 	textTrack := &TextTrack{}
 
-	resp, err := s.client.Do(req, textTrack)
+	resp, err := s.client.Do(ctx, req, textTrack)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return textTrack, resp, nil
+}
+
+// UploadTextTrack creates a text track and uploads its WebVTT content read from r.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/upload/texttracks
+func (s *VideosService) UploadTextTrack(ctx context.Context, vid int, r io.Reader, opt *TextTrackRequest) (*TextTrack, *Response, error) {
+	textTrack, resp, err := s.AddTextTrack(ctx, vid, opt)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if textTrack.Link == "" {
+		return nil, resp, errors.New("the text track upload link is missing")
+	}
+
+	req, err := http.NewRequest("PUT", textTrack.Link, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", "text/vtt")
+
+	resp, err = s.client.Do(ctx, req, nil)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -67,7 +105,7 @@ func (s *VideosService) AddTextTrack(vid int, r *TextTrackRequest) (*TextTrack,
 // GetTextTrack get specific text track by ID.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/texttracks/%7Btexttrack_id%7D
-func (s *VideosService) GetTextTrack(vid int, tid int) (*TextTrack, *Response, error) {
+func (s *VideosService) GetTextTrack(ctx context.Context, vid int, tid int) (*TextTrack, *Response, error) {
 	u := fmt.Sprintf("videos/%d/texttracks/%d", vid, tid)
 	req, err := s.client.NewRequest("GET", u, nil)
 	if err != nil {
@@ -76,7 +114,7 @@ func (s *VideosService) GetTextTrack(vid int, tid int) (*TextTrack, *Response, e
 
 	textTrack := &TextTrack{}
 
-	resp, err := s.client.Do(req, textTrack)
+	resp, err := s.client.Do(ctx, req, textTrack)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -87,7 +125,7 @@ func (s *VideosService) GetTextTrack(vid int, tid int) (*TextTrack, *Response, e
 // EditTextTrack edit specific text track by ID.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/texttracks/%7Btexttrack_id%7D
-func (s *VideosService) EditTextTrack(vid int, tid int, r *TextTrackRequest) (*TextTrack, *Response, error) {
+func (s *VideosService) EditTextTrack(ctx context.Context, vid int, tid int, r *TextTrackRequest) (*TextTrack, *Response, error) {
 	u := fmt.Sprintf("videos/%d/texttracks/%d", vid, tid)
 	req, err := s.client.NewRequest("PATCH", u, r)
 	if err != nil {
@@ -95,7 +133,7 @@ func (s *VideosService) EditTextTrack(vid int, tid int, r *TextTrackRequest) (*T
 	}
 
 	textTrack := &TextTrack{}
-	resp, err := s.client.Do(req, textTrack)
+	resp, err := s.client.Do(ctx, req, textTrack)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -106,12 +144,12 @@ func (s *VideosService) EditTextTrack(vid int, tid int, r *TextTrackRequest) (*T
 // DeleteTextTrack delete specific text track by ID.
 //
 // Vimeo API docs: https://developer.vimeo.com/api/playground/videos/%7Bvideo_id%7D/texttracks/%7Btexttrack_id%7D
-func (s *VideosService) DeleteTextTrack(vid int, tid int) (*Response, error) {
+func (s *VideosService) DeleteTextTrack(ctx context.Context, vid int, tid int) (*Response, error) {
 	u := fmt.Sprintf("videos/%d/texttracks/%d", vid, tid)
 	req, err := s.client.NewRequest("DELETE", u, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	return s.client.Do(ctx, req, nil)
 }
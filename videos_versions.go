@@ -0,0 +1,57 @@
+package vimeo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// VideoVersion represents a version of the source file of a video.
+type VideoVersion struct {
+	URI         string       `json:"uri,omitempty"`
+	FileName    string       `json:"filename,omitempty"`
+	Active      bool         `json:"active,omitempty"`
+	CreatedTime Timestamp    `json:"created_time,omitempty"`
+	Upload      *VideoUpload `json:"upload,omitempty"`
+}
+
+type videoVersionRequest struct {
+	Upload *tusUploadRequest `json:"upload"`
+}
+
+// ReplaceSource replaces the source file of the video, keeping its URI,
+// stats and embed settings. A new version is created and the file is
+// uploaded with the resumable tus approach. Unless the file is an
+// io.ReaderAt, a failed upload can't be resumed.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/upload/videos#replacing-a-source-file
+func (s *VideosService) ReplaceSource(ctx context.Context, vid int, file io.Reader, size int64) (*Video, *Response, error) {
+	u := fmt.Sprintf("videos/%d/versions", vid)
+	body := &videoVersionRequest{
+		Upload: &tusUploadRequest{Approach: "tus", Size: size},
+	}
+
+	req, err := s.client.NewRequest("POST", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	version := &VideoVersion{}
+
+	resp, err := s.client.Do(ctx, req, version)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if version.Upload == nil || version.Upload.UploadLink == "" {
+		return nil, resp, errors.New("the video version has no upload link")
+	}
+
+	resp, err = sendChunks(ctx, s.client, version.Upload.UploadLink, file, size, 0, &UploadOptions{})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return getVideo(ctx, s.client, fmt.Sprintf("videos/%d", vid))
+}
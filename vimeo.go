@@ -2,13 +2,19 @@ package vimeo
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/go-querystring/query"
 )
@@ -18,28 +24,147 @@ const (
 	defaultBaseURL   = "https://api.vimeo.com/"
 	defaultUserAgent = "go-vimeo/" + libraryVersion
 
-	mediaTypeVersion = "application/vnd.vimeo.*+json;version=3.2"
+	mediaType         = "application/vnd.vimeo.*+json"
+	defaultAPIVersion = "3.4"
+
+	headerRateLimit     = "X-RateLimit-Limit"
+	headerRateRemaining = "X-RateLimit-Remaining"
+	headerRateReset     = "X-RateLimit-Reset"
+
+	// defaultConcurrency is the default Client.Concurrency.
+	defaultConcurrency = 4
+
+	// maxPerPage is the largest number of items per page allowed by Vimeo.
+	maxPerPage = 100
 )
 
 // Client manages communication with Vimeo API.
+//
+// A Client and its services are safe for concurrent use by multiple
+// goroutines. Its exported fields must be set before the first request,
+// the token may be changed at any time with SetToken.
 type Client struct {
 	client *http.Client
 
+	mu    sync.Mutex
+	token *Token
+	rate  Rate // the most recent rate limit sent by the API
+
+	// BaseURL is the base URL of the API requests. Its path must end with
+	// a slash, relative request paths are resolved against it.
 	BaseURL *url.URL
 
+	// UserAgent is sent with every request, including the uploads and
+	// downloads made outside of the API, "go-vimeo/<version>" by default.
+	// Set it to identify the application to Vimeo.
 	UserAgent string
 
+	// APIVersion is the version of the API requested in the Accept header,
+	// so that the shape of the responses doesn't change under the client.
+	// It can be overridden per call with WithAPIVersion.
+	APIVersion string
+
+	// RetryMax is the maximum number of retries of a failed request,
+	// zero disables the retries. Requests are retried on connection errors,
+	// on 429 Too Many Requests and on 5xx responses.
+	RetryMax int
+	// RetryWaitMin and RetryWaitMax bound the exponential backoff between
+	// retries. A Retry-After header sent by the API takes precedence.
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+	// RetryNonIdempotent enables the retries of POST and PATCH requests on
+	// 5xx responses. By default they are retried on connection errors and on
+	// 429 Too Many Requests only, the API didn't process them.
+	RetryNonIdempotent bool
+
+	// RateLimitBlock makes the requests wait for the reset of the rate
+	// limit window, instead of failing with 429 Too Many Requests, once the
+	// last response reported no remaining requests.
+	RateLimitBlock bool
+
+	// Limiter, if set, is waited for before every round trip to the API,
+	// retries included, e.g. to cap the number of requests per second of
+	// all the goroutines sharing the client. By default there is no limit.
+	Limiter Limiter
+
+	// Concurrency limits the number of parallel requests made by the helpers
+	// that fan out over many resources, such as UsersService.GetBatch.
+	// Zero means 4.
+	Concurrency int
+
+	// PerPage is the number of items per page fetched by the iterators,
+	// such as UsersService.SearchAll, when ListOptions.PerPage is zero.
+	// It's capped to 100, the Vimeo maximum. Zero keeps the API default.
+	PerPage int
+
+	// Cache, if set, stores the API GET responses with an ETag, except the
+	// ones streamed to an io.Writer such as downloads. The following
+	// requests of the same URL send If-None-Match and are served from the
+	// cache on 304 Not Modified. See NewLRUCache.
+	Cache Cache
+
+	// OnResponse, if set, is called after every round trip to the API,
+	// including each retry, e.g. to log the requests. It may be called
+	// concurrently.
+	OnResponse func(*RequestLog)
+
 	// Services used for communicating with the API
+	Albums          *AlbumsService
 	Categories      *CategoriesService
 	Channels        *ChannelsService
+	Comments        *CommentsService
 	ContentRatings  *ContentRatingsService
 	CreativeCommons *CreativeCommonsService
 	Groups          *GroupsService
 	Languages       *LanguagesService
+	Live            *LiveService
+	OnDemand        *OnDemandService
+	Portfolios      *PortfoliosService
+	Presets         *PresetsService
+	Projects        *ProjectsService
 	Tags            *TagsService
+	Upload          *UploadService
 	Videos          *VideosService
 	MeVideos        *VideosService
 	Users           *UsersService
+	Webhooks        *WebhooksService
+}
+
+// Limiter limits the rate of the requests sent by a Client. Wait blocks
+// until a request can be sent, or returns an error if ctx is done first.
+// A *rate.Limiter of golang.org/x/time/rate is a Limiter.
+type Limiter interface {
+	Wait(ctx context.Context) error
+}
+
+// RoundTripperFunc is an adapter to use an ordinary function as an
+// http.RoundTripper, e.g. to stub the API responses in tests:
+//
+//	client := vimeo.NewClient(&http.Client{Transport: vimeo.RoundTripperFunc(stub)})
+type RoundTripperFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip calls f(req).
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func (c *Client) concurrency() int {
+	if c.Concurrency > 0 {
+		return c.Concurrency
+	}
+	return defaultConcurrency
+}
+
+// perPage returns the number of items per page for an iterator given the
+// requested one, zero for the API default.
+func (c *Client) perPage(n int) int {
+	if n == 0 {
+		n = c.PerPage
+	}
+	if n > maxPerPage {
+		return maxPerPage
+	}
+	return n
 }
 
 type service struct {
@@ -56,61 +181,139 @@ func NewClient(httpClient *http.Client) *Client {
 	}
 	baseURL, _ := url.Parse(defaultBaseURL)
 
-	c := &Client{client: httpClient, BaseURL: baseURL, UserAgent: defaultUserAgent}
+	c := &Client{
+		client:       httpClient,
+		BaseURL:      baseURL,
+		UserAgent:    defaultUserAgent,
+		APIVersion:   defaultAPIVersion,
+		RetryWaitMin: defaultRetryWaitMin,
+		RetryWaitMax: defaultRetryWaitMax,
+	}
+	c.Albums = &AlbumsService{client: c}
 	c.Categories = &CategoriesService{client: c}
 	c.Channels = &ChannelsService{client: c}
+	c.Comments = &CommentsService{client: c}
 	c.ContentRatings = &ContentRatingsService{client: c}
 	c.CreativeCommons = &CreativeCommonsService{client: c}
 	c.Groups = &GroupsService{client: c}
 	c.Languages = &LanguagesService{client: c}
+	c.Live = &LiveService{client: c}
+	c.OnDemand = &OnDemandService{client: c}
+	c.Portfolios = &PortfoliosService{client: c}
+	c.Presets = &PresetsService{client: c}
+	c.Projects = &ProjectsService{client: c}
 	c.Tags = &TagsService{client: c}
+	c.Upload = &UploadService{client: c}
 	c.Videos = &VideosService{client: c}
 	c.MeVideos = &VideosService{client: c, urlPrefix: "me/"}
 	c.Users = &UsersService{client: c}
+	c.Webhooks = &WebhooksService{client: c}
 	return c
 }
 
+// SetBaseURL sets the base URL of the API requests, such as the URL of
+// a local mock server. A trailing slash is added to the path if missing.
+func (c *Client) SetBaseURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+
+	if !strings.HasSuffix(u.Path, "/") {
+		u.Path += "/"
+	}
+
+	c.BaseURL = u
+	return nil
+}
+
 // Client returns the HTTP client configured for this client.
 func (c *Client) Client() *http.Client {
 	return c.client
 }
 
-// NewRequest creates an API request.
+// NewRequest creates an API request. The body, if not nil, is sent JSON encoded.
+// The encoded body is buffered, so that the request can be sent again on retry.
 func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Request, error) {
-	rel, err := url.Parse(urlStr)
+	if body == nil {
+		return c.NewRequestWithBody(method, urlStr, nil, "")
+	}
+
+	buf := new(bytes.Buffer)
+	err := json.NewEncoder(buf).Encode(body)
 	if err != nil {
 		return nil, err
 	}
 
-	u := c.BaseURL.ResolveReference(rel)
+	return c.NewRequestWithBody(method, urlStr, bytes.NewReader(buf.Bytes()), "application/json")
+}
 
-	var buf io.ReadWriter
-	if body != nil {
-		buf = new(bytes.Buffer)
-		err = json.NewEncoder(buf).Encode(body)
-		if err != nil {
-			return nil, err
-		}
+// NewRequestWithBody creates an API request sending the body as is, for
+// non-JSON payloads. The Content-Type header is set to contentType if not empty.
+// A *bytes.Buffer, *bytes.Reader or *strings.Reader body can be sent again on
+// retry, other readers can't.
+func (c *Client) NewRequestWithBody(method, urlStr string, body io.Reader, contentType string) (*http.Request, error) {
+	if c.BaseURL.Path != "" && !strings.HasSuffix(c.BaseURL.Path, "/") {
+		return nil, fmt.Errorf("BaseURL must have a trailing slash, but %q does not", c.BaseURL)
+	}
+
+	rel, err := url.Parse(urlStr)
+	if err != nil {
+		return nil, err
 	}
 
-	req, err := http.NewRequest(method, u.String(), buf)
+	u := c.BaseURL.ResolveReference(rel)
+
+	req, err := http.NewRequest(method, u.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
 	}
 
-	req.Header.Set("Accept", mediaTypeVersion)
+	req.Header.Set("Accept", acceptHeader(c.APIVersion))
 
 	if c.UserAgent != "" {
 		req.Header.Set("User-Agent", c.UserAgent)
 	}
 
+	if t := c.Token(); t != nil && t.AccessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+t.AccessToken)
+	}
+
 	return req, nil
 }
 
+// GetByURI requests the resource at the URI returned by the API, such as
+// "/users/1" or "/videos/1/comments", and decodes it into v. The URI is
+// resolved against BaseURL, full URLs are rejected so the token is never
+// sent to another host.
+func (c *Client) GetByURI(ctx context.Context, uri string, v interface{}) (*Response, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, err
+	}
+	if u.IsAbs() || u.Host != "" || !strings.HasPrefix(u.Path, "/") {
+		return nil, fmt.Errorf("invalid URI %q, must be a path such as \"/users/1\"", uri)
+	}
+
+	req, err := c.NewRequest("GET", pagePath(uri), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Do(ctx, req, v)
+}
+
+// pagePath returns the URI returned by the API, such as a Response.NextPage
+// of "/videos/1/comments?page=2", relative to BaseURL so that the path of a
+// custom BaseURL is kept.
+func pagePath(uri string) string {
+	return strings.TrimPrefix(uri, "/")
+}
+
 // NewUploadRequest creates an upload request.
 func (c *Client) NewUploadRequest(url string, reader io.Reader, size, lastByte int64) (*http.Request, error) {
 	req, err := http.NewRequest("PUT", url, reader)
@@ -126,31 +329,51 @@ func (c *Client) NewUploadRequest(url string, reader io.Reader, size, lastByte i
 
 // Do sends an API request and returns the API response. The API response is JSON decoded and stored in the value
 // pointed to by v, or returned as an error if an API error has occurred. If v implements the io.Writer interface,
-// the raw response will be written to v, without attempting to decode it.
-func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
-	resp, err := c.client.Do(req)
+// the raw response will be written to v, without attempting to decode it, whatever its content type.
+// Nothing is decoded when v is nil, the response is 204 No Content or its body is empty.
+//
+// The provided ctx must be non-nil. If it is canceled or times out, ctx.Err() will be returned.
+func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	if ctx == nil {
+		return nil, errors.New("context must be non-nil")
+	}
+
+	req = req.WithContext(ctx)
+	c.applyUserAgent(req)
+	if c.apiRequest(req) {
+		applyFields(ctx, req)
+		applyAPIVersion(ctx, req)
+	}
+	key, cached := c.cacheRequest(req, v)
+
+	resp, err := c.send(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
+	body := resp.Body
 	defer func() {
-		io.CopyN(ioutil.Discard, resp.Body, 512)
-		resp.Body.Close()
+		io.CopyN(ioutil.Discard, body, 512)
+		body.Close()
 	}()
 
 	response := newResponse(resp)
 
-	err = CheckResponse(resp)
+	response.Cached, err = c.cacheResponse(key, cached, resp)
 	if err != nil {
 		return response, err
 	}
 
-	if v != nil {
+	if !response.Cached {
+		err = CheckResponse(resp)
+		if err != nil {
+			return response, err
+		}
+	}
+
+	if v != nil && resp.StatusCode != http.StatusNoContent {
 		if w, ok := v.(io.Writer); ok {
 			_, err = io.Copy(w, resp.Body)
-			if err != nil {
-				return nil, err
-			}
 		} else {
 			err = json.NewDecoder(resp.Body).Decode(v)
 			if err == io.EOF {
@@ -162,9 +385,92 @@ func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
 	return response, err
 }
 
+type contextKey int
+
+const (
+	fieldsKey contextKey = iota
+	apiVersionKey
+	rateLimitRetriesKey
+)
+
+// WithFields returns a copy of ctx that limits the responses of the API
+// requests made with it to the given fields. It's the per call equivalent
+// of ListOptions.Fields, usable with any method. The uploads and downloads
+// to other hosts are left as is:
+//
+//	ctx := vimeo.WithFields(ctx, "uri", "name")
+//	user, _, err := client.Users.Get(ctx, "")
+func WithFields(ctx context.Context, fields ...string) context.Context {
+	return context.WithValue(ctx, fieldsKey, fields)
+}
+
+// apiRequest reports whether req is sent to the API, rather than to an
+// upload or download link of another host.
+func (c *Client) apiRequest(req *http.Request) bool {
+	return c.BaseURL != nil && req.URL.Host == c.BaseURL.Host
+}
+
+// applyUserAgent sets Client.UserAgent on the requests not created by
+// NewRequest, such as the uploads, without changing the caller's headers.
+func (c *Client) applyUserAgent(req *http.Request) {
+	if c.UserAgent == "" || req.Header.Get("User-Agent") != "" {
+		return
+	}
+
+	h := req.Header.Clone()
+	if h == nil {
+		h = http.Header{}
+	}
+	h.Set("User-Agent", c.UserAgent)
+	req.Header = h
+}
+
+// applyFields sets the fields query parameter from the context, unless the
+// request already has one.
+func applyFields(ctx context.Context, req *http.Request) {
+	fields, _ := ctx.Value(fieldsKey).([]string)
+	if len(fields) == 0 {
+		return
+	}
+
+	q := req.URL.Query()
+	if q.Get("fields") != "" {
+		return
+	}
+	q.Set("fields", strings.Join(fields, ","))
+
+	u := *req.URL
+	u.RawQuery = q.Encode()
+	req.URL = &u
+}
+
+// acceptHeader returns the media type requesting the version of the API.
+func acceptHeader(version string) string {
+	if version == "" {
+		return mediaType
+	}
+	return mediaType + ";version=" + version
+}
+
+// WithAPIVersion returns a copy of ctx that requests the given version of the
+// API for the API requests made with it, instead of Client.APIVersion. The
+// requests to other hosts, such as the uploads, keep their Accept header.
+func WithAPIVersion(ctx context.Context, version string) context.Context {
+	return context.WithValue(ctx, apiVersionKey, version)
+}
+
+// applyAPIVersion sets the Accept header from the context version, if any.
+func applyAPIVersion(ctx context.Context, req *http.Request) {
+	if version, ok := ctx.Value(apiVersionKey).(string); ok {
+		req.Header = req.Header.Clone()
+		req.Header.Set("Accept", acceptHeader(version))
+	}
+}
+
 type paginator interface {
 	GetPage() int
 	GetTotal() int
+	GetPerPage() int
 	GetPaging() (string, string, string, string)
 }
 
@@ -176,9 +482,10 @@ type paging struct {
 }
 
 type pagination struct {
-	Total  int    `json:"total,omitempty"`
-	Page   int    `json:"page,omitempty"`
-	Paging paging `json:"paging,omitempty"`
+	Total   int    `json:"total,omitempty"`
+	Page    int    `json:"page,omitempty"`
+	PerPage int    `json:"per_page,omitempty"`
+	Paging  paging `json:"paging,omitempty"`
 }
 
 // GetPage returns the current page number.
@@ -186,47 +493,237 @@ func (p pagination) GetPage() int {
 	return p.Page
 }
 
-// GetTotal returns the total number of pages.
+// GetTotal returns the total number of items.
 func (p pagination) GetTotal() int {
 	return p.Total
 }
 
+// GetPerPage returns the number of items per page.
+func (p pagination) GetPerPage() int {
+	return p.PerPage
+}
+
 // GetPaging returns the data pagination presented as relative references.
 // In the following procedure: next, previous, first, last page.
 func (p pagination) GetPaging() (string, string, string, string) {
 	return p.Paging.Next, p.Paging.Prev, p.Paging.First, p.Paging.Last
 }
 
-// Response is a Vimeo response. This wraps the standard http.Response.
-// Provides access pagination links.
+// Response is a Vimeo response. This wraps the standard http.Response, whose
+// status and headers remain accessible, and provides access pagination links.
+// Its body has already been read and closed by Client.Do.
 type Response struct {
 	*http.Response
 	// Pagination
-	Page       int
+	Page    int
+	PerPage int
+	// Total is the number of items of all the pages.
+	Total int
+	// Deprecated: TotalPages holds the number of items despite its name, use Total.
 	TotalPages int
-	NextPage   string
-	PrevPage   string
-	FirstPage  string
-	LastPage   string
+	// The relative references of the other pages, empty if there is none.
+	NextPage  string
+	PrevPage  string
+	FirstPage string
+	LastPage  string
+
+	// LocationURI is the Location header as is, such as the URI of a created
+	// resource. The Location method resolves it to an absolute URL.
+	LocationURI string
+	// Links are the URLs of the RFC 5988 Link header keyed by relation type.
+	Links map[string]string
+
+	// Rate limits of the client at the time of the request.
+	Rate Rate
+
+	// Cached reports whether the body was served from Client.Cache, the API
+	// having answered 304 Not Modified.
+	Cached bool
+}
+
+// HasNext reports whether there is a page after the current one.
+func (r *Response) HasNext() bool {
+	return r.NextPage != ""
+}
+
+// HasPrev reports whether there is a page before the current one.
+func (r *Response) HasPrev() bool {
+	return r.PrevPage != ""
+}
+
+// Rate represents the rate limit for the current client.
+type Rate struct {
+	// The number of requests per hour the client is currently limited to.
+	Limit int
+	// The number of remaining requests the client can make this hour.
+	Remaining int
+	// The time at which the current rate limit will reset.
+	Reset time.Time
 }
 
+// setPaging fills the pagination fields from the body paging block.
+// Links missing from the body are taken from the RFC 5988 Link header.
 func (r *Response) setPaging(p paginator) {
 	r.Page = p.GetPage()
-	r.TotalPages = p.GetTotal()
+	r.PerPage = p.GetPerPage()
+	r.Total = p.GetTotal()
+	r.TotalPages = r.Total
 	r.NextPage, r.PrevPage, r.FirstPage, r.LastPage = p.GetPaging()
+
+	if r.Response == nil {
+		return
+	}
+
+	links := r.Links
+	if links == nil {
+		links = parseLinkHeader(r.Header["Link"])
+	}
+	if r.NextPage == "" {
+		r.NextPage = links["next"]
+	}
+	if r.PrevPage == "" {
+		r.PrevPage = links["prev"]
+	}
+	if r.PrevPage == "" {
+		r.PrevPage = links["previous"]
+	}
+	if r.FirstPage == "" {
+		r.FirstPage = links["first"]
+	}
+	if r.LastPage == "" {
+		r.LastPage = links["last"]
+	}
+}
+
+// parseLinkHeader parses RFC 5988 Link header values into a map of
+// URLs keyed by relation type.
+func parseLinkHeader(headers []string) map[string]string {
+	links := make(map[string]string)
+
+	for _, header := range headers {
+		for _, link := range strings.Split(header, ",") {
+			segments := strings.Split(strings.TrimSpace(link), ";")
+			if len(segments) < 2 {
+				continue
+			}
+
+			target := strings.TrimSpace(segments[0])
+			if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+			target = target[1 : len(target)-1]
+
+			for _, param := range segments[1:] {
+				kv := strings.SplitN(strings.TrimSpace(param), "=", 2)
+				if len(kv) != 2 || strings.TrimSpace(kv[0]) != "rel" {
+					continue
+				}
+
+				for _, rel := range strings.Fields(strings.Trim(kv[1], `"`)) {
+					links[rel] = target
+				}
+			}
+		}
+	}
+
+	return links
 }
 
 // ErrorResponse is a Vimeo error response. This wraps the standard http.Response.
 // Provides access error message returned Vimeo.
+//
+// Use errors.As to inspect the error returned by the API methods:
+//
+//	var errResp *vimeo.ErrorResponse
+//	if errors.As(err, &errResp) && errResp.ErrorCode == 2204 {
+//		...
+//	}
 type ErrorResponse struct {
-	Response *http.Response
-	Message  string `json:"error"`
+	Response         *http.Response
+	Message          string `json:"error"`
+	Link             string `json:"link,omitempty"`
+	DeveloperMessage string `json:"developer_message,omitempty"`
+	ErrorCode        int    `json:"error_code,omitempty"`
 }
 
 func (r *ErrorResponse) Error() string {
-	return fmt.Sprintf("%v %v: %d %v",
+	msg := fmt.Sprintf("%v %v: %d %v",
 		r.Response.Request.Method, sanitizeURL(r.Response.Request.URL),
 		r.Response.StatusCode, r.Message)
+	if r.ErrorCode != 0 {
+		msg += fmt.Sprintf(" (error code %d)", r.ErrorCode)
+	}
+	return msg
+}
+
+// Errors matched by an ErrorResponse with the corresponding status code,
+// e.g. errors.Is(err, ErrNotFound). The ErrorResponse itself is still
+// available with errors.As.
+var (
+	ErrUnauthorized = errors.New("vimeo: unauthorized")
+	ErrForbidden    = errors.New("vimeo: forbidden")
+	ErrNotFound     = errors.New("vimeo: not found")
+	ErrRateLimited  = errors.New("vimeo: rate limited")
+)
+
+// ErrMaxPagesReached is returned by the iterators when they stop at
+// ListOptions.MaxPages while more pages remain.
+var ErrMaxPagesReached = errors.New("vimeo: max pages reached")
+
+// Is reports whether the status code of the response matches target,
+// one of ErrUnauthorized, ErrForbidden, ErrNotFound or ErrRateLimited.
+func (r *ErrorResponse) Is(target error) bool {
+	if r.Response == nil {
+		return false
+	}
+
+	switch r.Response.StatusCode {
+	case http.StatusUnauthorized:
+		return target == ErrUnauthorized
+	case http.StatusForbidden:
+		return target == ErrForbidden
+	case http.StatusNotFound:
+		return target == ErrNotFound
+	case http.StatusTooManyRequests:
+		return target == ErrRateLimited
+	}
+	return false
+}
+
+// RequestLog describes a round trip to the API passed to Client.OnResponse.
+// The client_secret parameter of URL and the Authorization header are redacted.
+type RequestLog struct {
+	Method   string
+	URL      *url.URL
+	Header   http.Header
+	Status   int // zero if the request failed
+	Duration time.Duration
+	Err      error
+}
+
+func (c *Client) logResponse(req *http.Request, resp *http.Response, start time.Time, err error) {
+	if c.OnResponse == nil {
+		return
+	}
+
+	u := *req.URL
+	header := req.Header.Clone()
+	if header.Get("Authorization") != "" {
+		header.Set("Authorization", "REDACTED")
+	}
+
+	l := &RequestLog{
+		Method:   req.Method,
+		URL:      sanitizeURL(&u),
+		Header:   header,
+		Duration: time.Since(start),
+		Err:      err,
+	}
+	if resp != nil {
+		l.Status = resp.StatusCode
+	}
+
+	c.OnResponse(l)
 }
 
 func sanitizeURL(uri *url.URL) *url.URL {
@@ -243,9 +740,43 @@ func sanitizeURL(uri *url.URL) *url.URL {
 
 func newResponse(r *http.Response) *Response {
 	response := &Response{Response: r}
+	response.LocationURI = r.Header.Get("Location")
+	response.Links = parseLinkHeader(r.Header["Link"])
+	response.Rate = parseRate(r)
 	return response
 }
 
+// parseRate parses the rate related headers.
+func parseRate(r *http.Response) Rate {
+	var rate Rate
+	if limit := r.Header.Get(headerRateLimit); limit != "" {
+		rate.Limit, _ = strconv.Atoi(limit)
+	}
+	if remaining := r.Header.Get(headerRateRemaining); remaining != "" {
+		rate.Remaining, _ = strconv.Atoi(remaining)
+	}
+	if reset := r.Header.Get(headerRateReset); reset != "" {
+		rate.Reset = parseRateReset(reset)
+	}
+	return rate
+}
+
+// parseRateReset parses the reset time sent either as Unix seconds or as
+// a RFC 1123 or RFC 3339 date.
+func parseRateReset(v string) time.Time {
+	if sec, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return time.Unix(sec, 0)
+	}
+
+	for _, layout := range []string{time.RFC1123, time.RFC1123Z, time.RFC3339} {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
 // CheckResponse checks the API response for errors, and returns them if
 // present.  A response is considered an error if it has a status code outside
 // the 200 range.  API error responses are expected to have either no response
@@ -273,6 +804,67 @@ type ListOptions struct {
 	PerPage   int `url:"per_page,omitempty"`
 	Sort      int `url:"sort,omitempty"`
 	Direction int `url:"direction,omitempty"`
+
+	// Fields limits the response to the given fields, such as "uri",
+	// "name" or "pictures.sizes".
+	Fields []string `url:"fields,comma,omitempty"`
+
+	// Extra holds query parameters not modelled by the options, such as a
+	// new "filter_*" parameter. The parameters set by the other fields take
+	// precedence over Extra on the same key.
+	Extra url.Values `url:"-"`
+
+	// MaxPages stops the iterators, such as UsersService.SearchAll, after
+	// that many pages with ErrMaxPagesReached if more remain. Zero means no
+	// limit. The other methods ignore it.
+	MaxPages int `url:"-"`
+}
+
+func (o ListOptions) extraValues() url.Values {
+	return o.Extra
+}
+
+// Bool is a helper routine that allocates a new bool value
+// to store v and returns a pointer to it.
+func Bool(v bool) *bool { return &v }
+
+// sanitizeID returns an error if the user ID can't be used as a single path
+// segment, such as "../me" or "1%2F2". The empty ID stands for "me".
+func sanitizeID(id string) error {
+	if id == "." || id == ".." {
+		return fmt.Errorf("invalid ID %q", id)
+	}
+
+	for _, r := range id {
+		switch {
+		case 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z', '0' <= r && r <= '9':
+		case r == '-', r == '_', r == '.', r == '~':
+		default:
+			return fmt.Errorf("invalid ID %q", id)
+		}
+	}
+
+	return nil
+}
+
+// userPath returns the path of the user resource formatted from format and
+// a, such as "users/1/albums/2" for userPath("1", "albums/%d", 2). The empty
+// user ID stands for "me" and the empty format for the user itself. It
+// returns an error if the user ID is invalid, see sanitizeID.
+func userPath(uid string, format string, a ...interface{}) (string, error) {
+	if err := sanitizeID(uid); err != nil {
+		return "", err
+	}
+
+	u := "me"
+	if uid != "" {
+		u = "users/" + uid
+	}
+
+	if format == "" {
+		return u, nil
+	}
+	return u + "/" + fmt.Sprintf(format, a...), nil
 }
 
 func addOptions(s string, opt interface{}) (string, error) {
@@ -291,6 +883,45 @@ func addOptions(s string, opt interface{}) (string, error) {
 		return s, err
 	}
 
+	if o, ok := opt.(interface{ extraValues() url.Values }); ok {
+		for k, vs := range o.extraValues() {
+			if _, ok := qs[k]; !ok {
+				qs[k] = vs
+			}
+		}
+	}
+
 	u.RawQuery = qs.Encode()
 	return u.String(), nil
 }
+
+// countOptions are the options of a list requested only for its total,
+// which is part of the envelope: a single item reduced to its URI.
+func countOptions() *ListOptions {
+	return &ListOptions{PerPage: 1, Fields: []string{"uri"}}
+}
+
+// countList requests a single item of the list and returns only the total
+// number of items reported by the API.
+func countList(ctx context.Context, c *Client, url string) (int, *Response, error) {
+	u, err := addOptions(url, countOptions())
+	if err != nil {
+		return 0, nil, err
+	}
+
+	req, err := c.NewRequest("GET", u, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	p := &pagination{}
+
+	resp, err := c.Do(ctx, req, p)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	resp.setPaging(p)
+
+	return p.Total, resp, err
+}
@@ -3,7 +3,9 @@ package vimeo
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -11,7 +13,9 @@ import (
 	"net/url"
 	"reflect"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 var (
@@ -109,8 +113,8 @@ func TestNewRequest(t *testing.T) {
 		t.Errorf("NewRequest header User-Agent is %v, want %v", headerUA, c.UserAgent)
 	}
 
-	if headerAccept := req.Header.Get("Accept"); headerAccept != mediaTypeVersion {
-		t.Errorf("NewRequest header Accept is %v, want %v", headerAccept, mediaTypeVersion)
+	if headerAccept, want := req.Header.Get("Accept"), "application/vnd.vimeo.*+json;version=3.4"; headerAccept != want {
+		t.Errorf("NewRequest header Accept is %v, want %v", headerAccept, want)
 	}
 }
 
@@ -127,6 +131,36 @@ func TestNewRequest_badURL(t *testing.T) {
 	}
 }
 
+func TestNewRequest_baseURLWithoutTrailingSlash(t *testing.T) {
+	c := NewClient(nil)
+	c.BaseURL, _ = url.Parse("https://example.com/api")
+
+	if _, err := c.NewRequest("GET", "users/1", nil); err == nil {
+		t.Error("Expected error to be returned")
+	}
+}
+
+func TestClient_SetBaseURL(t *testing.T) {
+	c := NewClient(nil)
+
+	if err := c.SetBaseURL("http://127.0.0.1:8080/api"); err != nil {
+		t.Fatalf("SetBaseURL returned unexpected error: %v", err)
+	}
+
+	req, err := c.NewRequest("GET", "users/123", nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned unexpected error: %v", err)
+	}
+
+	if got, want := req.URL.String(), "http://127.0.0.1:8080/api/users/123"; got != want {
+		t.Errorf("NewRequest URL is %v, want %v", got, want)
+	}
+
+	if err := c.SetBaseURL(":"); err == nil {
+		t.Error("SetBaseURL expected error for invalid URL")
+	}
+}
+
 func TestNewRequest_emptyBody(t *testing.T) {
 	c := NewClient(nil)
 	req, err := c.NewRequest("GET", "/", nil)
@@ -144,7 +178,7 @@ func TestNewRequest_invalidJSON(t *testing.T) {
 	c := NewClient(nil)
 
 	type T struct {
-		F map[interface{}]interface{}
+		F chan int
 	}
 
 	_, err := c.NewRequest("GET", "/", &T{})
@@ -158,6 +192,62 @@ func TestNewRequest_invalidJSON(t *testing.T) {
 	}
 }
 
+func TestNewRequestWithBody(t *testing.T) {
+	c := NewClient(nil)
+
+	req, err := c.NewRequestWithBody("PUT", "foo", strings.NewReader("WEBVTT"), "text/vtt")
+	if err != nil {
+		t.Fatalf("NewRequestWithBody returned unexpected error: %v", err)
+	}
+
+	if got, want := req.URL.String(), defaultBaseURL+"foo"; got != want {
+		t.Errorf("NewRequestWithBody(%q) URL is %v, want %v", "foo", got, want)
+	}
+
+	if got, want := req.Header.Get("Content-Type"), "text/vtt"; got != want {
+		t.Errorf("NewRequestWithBody Content-Type is %v, want %v", got, want)
+	}
+
+	body, _ := ioutil.ReadAll(req.Body)
+	if got, want := string(body), "WEBVTT"; got != want {
+		t.Errorf("NewRequestWithBody Body is %v, want %v", got, want)
+	}
+}
+
+func TestClient_concurrentUse(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"uri": %q}`, r.URL.Path)
+	})
+
+	client.SetToken(&Token{AccessToken: "token"})
+
+	var wg sync.WaitGroup
+	for i := 1; i <= 20; i++ {
+		wg.Add(1)
+		go func(vid int) {
+			defer wg.Done()
+
+			video, _, err := client.Videos.Get(context.Background(), vid)
+			if err != nil {
+				t.Errorf("Videos.Get returned unexpected error: %v", err)
+				return
+			}
+
+			if want := fmt.Sprintf("/videos/%d", vid); video.URI != want {
+				t.Errorf("Videos.Get returned %q, want %q", video.URI, want)
+			}
+		}(i)
+
+		if i%5 == 0 {
+			client.SetToken(&Token{AccessToken: fmt.Sprintf("token%d", i)})
+		}
+	}
+	wg.Wait()
+}
+
 func TestNewRequest_emptyUserAgent(t *testing.T) {
 	c := NewClient(nil)
 
@@ -173,6 +263,57 @@ func TestNewRequest_emptyUserAgent(t *testing.T) {
 	}
 }
 
+func TestClient_GetByURI_basePath(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.BaseURL, _ = url.Parse(server.URL + "/api/")
+
+	mux.HandleFunc("/api/videos/1/comments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"total": 1}`)
+	})
+
+	p := &pagination{}
+	if _, err := client.GetByURI(context.Background(), "/videos/1/comments", p); err != nil {
+		t.Fatalf("GetByURI returned unexpected error: %v", err)
+	}
+
+	if p.Total != 1 {
+		t.Errorf("GetByURI decoded %+v, want total 1", p)
+	}
+}
+
+func TestClient_GetByURI_invalid(t *testing.T) {
+	c := NewClient(nil)
+
+	for _, uri := range []string{"https://example.com/users/1", "//example.com/users/1", "users/1"} {
+		if _, err := c.GetByURI(context.Background(), uri, nil); err == nil {
+			t.Errorf("GetByURI(%q) expected error to be returned", uri)
+		}
+	}
+}
+
+func TestDo_userAgent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.UserAgent = "app/1.0"
+
+	mux.HandleFunc("/upload", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "User-Agent", "app/1.0")
+	})
+
+	req, _ := http.NewRequest("PUT", server.URL+"/upload", nil)
+	if _, err := client.Do(context.Background(), req, nil); err != nil {
+		t.Fatalf("Do returned unexpected error: %v", err)
+	}
+
+	if got := req.Header.Get("User-Agent"); got != "" {
+		t.Errorf("Do changed the request User-Agent to %q", got)
+	}
+}
+
 func TestDo(t *testing.T) {
 	setup()
 	defer teardown()
@@ -191,7 +332,7 @@ func TestDo(t *testing.T) {
 	req, _ := client.NewRequest("GET", "/", nil)
 	body := new(T)
 
-	client.Do(req, body)
+	client.Do(context.Background(), req, body)
 
 	want := &T{"v"}
 	if !reflect.DeepEqual(body, want) {
@@ -199,6 +340,66 @@ func TestDo(t *testing.T) {
 	}
 }
 
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestDo_customHTTPClient(t *testing.T) {
+	calls := 0
+	httpClient := &http.Client{Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		calls++
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(strings.NewReader(`{}`)),
+			Request:    r,
+		}, nil
+	})}
+
+	c := NewClient(httpClient)
+	req, _ := c.NewRequest("GET", "users/1", nil)
+	if _, err := c.Do(context.Background(), req, nil); err != nil {
+		t.Errorf("Do returned unexpected error: %v", err)
+	}
+
+	if calls != 1 {
+		t.Errorf("Do sent %d requests through the custom transport, want 1", calls)
+	}
+}
+
+func TestDo_nilContext(t *testing.T) {
+	setup()
+	defer teardown()
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	_, err := client.Do(nil, req, nil)
+
+	if err == nil || err.Error() != "context must be non-nil" {
+		t.Errorf("Expected context must be non-nil error, got %v", err)
+	}
+}
+
+func TestDo_contextCanceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		<-r.Context().Done()
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	_, err := client.Do(ctx, req, nil)
+
+	if err != context.Canceled {
+		t.Errorf("Expected context.Canceled error, got %v", err)
+	}
+}
+
 func TestDo_httpError(t *testing.T) {
 	setup()
 	defer teardown()
@@ -208,7 +409,7 @@ func TestDo_httpError(t *testing.T) {
 	})
 
 	req, _ := client.NewRequest("GET", "/", nil)
-	_, err := client.Do(req, nil)
+	_, err := client.Do(context.Background(), req, nil)
 
 	if err == nil {
 		t.Error("Expected HTTP error.")
@@ -226,7 +427,7 @@ func TestDo_noContent(t *testing.T) {
 	var body json.RawMessage
 
 	req, _ := client.NewRequest("GET", "/", nil)
-	_, err := client.Do(req, &body)
+	_, err := client.Do(context.Background(), req, &body)
 
 	if err != nil {
 		t.Fatalf("Do returned unexpected error: %v", err)
@@ -248,11 +449,80 @@ func TestDo_ioWriter(t *testing.T) {
 	body := bufio.NewWriter(&b)
 
 	req, _ := client.NewRequest("GET", "/", nil)
-	_, err := client.Do(req, body)
+	_, err := client.Do(context.Background(), req, body)
+
+	if err != nil {
+		t.Errorf("Do returned unexpected error: %v", err)
+	}
+}
+
+func TestDo_ioWriter_binary(t *testing.T) {
+	setup()
+	defer teardown()
+
+	data := []byte{0x00, 0xff, 0x10}
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "video/mp4")
+		w.Write(data)
+	})
+
+	var b bytes.Buffer
+	req, _ := client.NewRequest("GET", "/", nil)
+	resp, err := client.Do(context.Background(), req, &b)
+	if err != nil {
+		t.Fatalf("Do returned unexpected error: %v", err)
+	}
+
+	if !bytes.Equal(b.Bytes(), data) {
+		t.Errorf("Do wrote %v, want %v", b.Bytes(), data)
+	}
+	if got, want := resp.Header.Get("Content-Type"), "video/mp4"; got != want {
+		t.Errorf("Response Content-Type is %q, want %q", got, want)
+	}
+}
+
+func TestDo_rateLimit(t *testing.T) {
+	setup()
+	defer teardown()
 
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "60")
+		w.Header().Set("X-RateLimit-Remaining", "59")
+		w.Header().Set("X-RateLimit-Reset", "Sun, 01 Jan 2017 01:00:00 GMT")
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	resp, err := client.Do(context.Background(), req, nil)
 	if err != nil {
 		t.Errorf("Do returned unexpected error: %v", err)
 	}
+
+	want := Rate{
+		Limit:     60,
+		Remaining: 59,
+		Reset:     time.Date(2017, time.January, 1, 1, 0, 0, 0, time.UTC),
+	}
+	if resp.Rate.Limit != want.Limit || resp.Rate.Remaining != want.Remaining || !resp.Rate.Reset.Equal(want.Reset) {
+		t.Errorf("Response.Rate is %+v, want %+v", resp.Rate, want)
+	}
+}
+
+func TestParseRateReset(t *testing.T) {
+	want := time.Date(2017, time.January, 1, 1, 0, 0, 0, time.UTC)
+
+	for _, v := range []string{
+		"1483232400",
+		"Sun, 01 Jan 2017 01:00:00 GMT",
+		"2017-01-01T01:00:00+00:00",
+	} {
+		if got := parseRateReset(v); !got.Equal(want) {
+			t.Errorf("parseRateReset(%q) returned %v, want %v", v, got, want)
+		}
+	}
+
+	if got := parseRateReset("soon"); !got.IsZero() {
+		t.Errorf("parseRateReset returned %v for invalid value, want zero time", got)
+	}
 }
 
 func TestPagination_GetPage(t *testing.T) {
@@ -269,6 +539,13 @@ func TestPagination_GetTotal(t *testing.T) {
 	}
 }
 
+func TestPagination_GetPerPage(t *testing.T) {
+	p := pagination{PerPage: 25}
+	if perPage := p.GetPerPage(); perPage != 25 {
+		t.Errorf("pagination GetPerPage is %v, want %v", perPage, 25)
+	}
+}
+
 func TestPagination_GetPaging(t *testing.T) {
 	p := pagination{
 		Paging: paging{
@@ -299,8 +576,9 @@ func TestPagination_GetPaging(t *testing.T) {
 
 func TestResponse_setPaging(t *testing.T) {
 	p := pagination{
-		Page:  1,
-		Total: 10,
+		Page:    1,
+		Total:   10,
+		PerPage: 2,
 		Paging: paging{
 			Next:  "/page=3",
 			Prev:  "/page=1",
@@ -315,6 +593,14 @@ func TestResponse_setPaging(t *testing.T) {
 		t.Errorf("Response Page is %v, want %v", resp.Page, p.Page)
 	}
 
+	if resp.PerPage != p.PerPage {
+		t.Errorf("Response PerPage is %v, want %v", resp.PerPage, p.PerPage)
+	}
+
+	if resp.Total != p.Total {
+		t.Errorf("Response Total is %v, want %v", resp.Total, p.Total)
+	}
+
 	if resp.TotalPages != p.Total {
 		t.Errorf("Response TotalPages is %v, want %v", resp.TotalPages, p.Total)
 	}
@@ -336,6 +622,113 @@ func TestResponse_setPaging(t *testing.T) {
 	}
 }
 
+func TestResponse_setPaging_linkHeader(t *testing.T) {
+	p := pagination{
+		Paging: paging{
+			Next: "/page=3",
+		},
+	}
+	resp := Response{Response: &http.Response{Header: http.Header{}}}
+	resp.Header.Add("Link", `<https://api.vimeo.com/page=4>; rel="next", <https://api.vimeo.com/page=1>; rel="prev first"`)
+	resp.Header.Add("Link", `<https://api.vimeo.com/page=10>; rel="last"`)
+	resp.setPaging(p)
+
+	if want := "/page=3"; resp.NextPage != want {
+		t.Errorf("Response NextPage is %v, want %v", resp.NextPage, want)
+	}
+
+	if want := "https://api.vimeo.com/page=1"; resp.PrevPage != want {
+		t.Errorf("Response PrevPage is %v, want %v", resp.PrevPage, want)
+	}
+
+	if want := "https://api.vimeo.com/page=1"; resp.FirstPage != want {
+		t.Errorf("Response FirstPage is %v, want %v", resp.FirstPage, want)
+	}
+
+	if want := "https://api.vimeo.com/page=10"; resp.LastPage != want {
+		t.Errorf("Response LastPage is %v, want %v", resp.LastPage, want)
+	}
+}
+
+func TestResponse_HasNext(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/videos", func(w http.ResponseWriter, r *http.Request) {
+		switch r.FormValue("page") {
+		case "2":
+			fmt.Fprint(w, `{"total": 3, "page": 2, "per_page": 2,
+				"paging": {"next": null, "previous": "/videos?page=1", "first": "/videos?page=1", "last": "/videos?page=2"},
+				"data": [{"name": "C"}]}`)
+		default:
+			fmt.Fprint(w, `{"total": 3, "page": 1, "per_page": 2,
+				"paging": {"next": "/videos?page=2", "previous": null, "first": "/videos?page=1", "last": "/videos?page=2"},
+				"data": [{"name": "A"}, {"name": "B"}]}`)
+		}
+	})
+
+	tests := []struct {
+		page             int
+		hasNext, hasPrev bool
+	}{
+		{1, true, false},
+		{2, false, true},
+	}
+
+	for _, tt := range tests {
+		opt := &ListVideoOptions{ListOptions: ListOptions{Page: tt.page}}
+		_, resp, err := client.Videos.List(context.Background(), opt)
+		if err != nil {
+			t.Fatalf("Videos.List returned unexpected error: %v", err)
+		}
+
+		if resp.Page != tt.page || resp.PerPage != 2 || resp.Total != 3 {
+			t.Errorf("page %d: Response has page %d, per page %d, total %d", tt.page, resp.Page, resp.PerPage, resp.Total)
+		}
+		if resp.HasNext() != tt.hasNext || resp.HasPrev() != tt.hasPrev {
+			t.Errorf("page %d: HasNext is %v, HasPrev is %v, want %v, %v", tt.page, resp.HasNext(), resp.HasPrev(), tt.hasNext, tt.hasPrev)
+		}
+	}
+}
+
+func TestDo_locationAndLinks(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/albums", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Location", "/users/1/albums/2")
+		w.Header().Add("Link", `</me/albums?page=2>; rel="next", </me/albums?page=3>; rel="last"`)
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	req, _ := client.NewRequest("POST", "/me/albums", nil)
+	resp, err := client.Do(context.Background(), req, nil)
+	if err != nil {
+		t.Fatalf("Do returned unexpected error: %v", err)
+	}
+
+	if want := "/users/1/albums/2"; resp.LocationURI != want {
+		t.Errorf("Response.LocationURI is %q, want %q", resp.LocationURI, want)
+	}
+
+	want := map[string]string{"next": "/me/albums?page=2", "last": "/me/albums?page=3"}
+	if !reflect.DeepEqual(resp.Links, want) {
+		t.Errorf("Response.Links is %v, want %v", resp.Links, want)
+	}
+}
+
+func TestParseLinkHeader(t *testing.T) {
+	links := parseLinkHeader([]string{`<https://api.vimeo.com/page=2>; rel="next", <https://api.vimeo.com/page=5>; rel=last, invalid`})
+
+	want := map[string]string{
+		"next": "https://api.vimeo.com/page=2",
+		"last": "https://api.vimeo.com/page=5",
+	}
+	if !reflect.DeepEqual(links, want) {
+		t.Errorf("parseLinkHeader returned %+v, want %+v", links, want)
+	}
+}
+
 func TestErrorResponse_Error(t *testing.T) {
 	res := &http.Response{
 		Request:    &http.Request{Method: "GET"},
@@ -376,6 +769,91 @@ func TestCheckError_statusOK(t *testing.T) {
 	}
 }
 
+func TestErrorResponse_Error_errorCode(t *testing.T) {
+	u, _ := url.Parse("https://api.vimeo.com/videos/1")
+	res := &http.Response{
+		Request:    &http.Request{Method: "GET", URL: u},
+		StatusCode: http.StatusNotFound,
+	}
+
+	errResponse := &ErrorResponse{Response: res, Message: "not found", ErrorCode: 5000}
+
+	want := "GET https://api.vimeo.com/videos/1: 404 not found (error code 5000)"
+	if got := errResponse.Error(); got != want {
+		t.Errorf("ErrorResponse.Error returned %q, want %q", got, want)
+	}
+}
+
+func TestErrorResponse_Is(t *testing.T) {
+	tests := []struct {
+		status int
+		want   error
+	}{
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrForbidden},
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusTooManyRequests, ErrRateLimited},
+	}
+
+	for _, tt := range tests {
+		err := error(&ErrorResponse{Response: &http.Response{StatusCode: tt.status}})
+		if !errors.Is(err, tt.want) {
+			t.Errorf("errors.Is(%d, %v) returned false, want true", tt.status, tt.want)
+		}
+		if errors.Is(err, errors.New(tt.want.Error())) {
+			t.Errorf("errors.Is(%d, another error) returned true, want false", tt.status)
+		}
+	}
+
+	err := error(&ErrorResponse{Response: &http.Response{StatusCode: http.StatusBadRequest}})
+	for _, target := range []error{ErrUnauthorized, ErrForbidden, ErrNotFound, ErrRateLimited} {
+		if errors.Is(err, target) {
+			t.Errorf("errors.Is(400, %v) returned true, want false", target)
+		}
+	}
+}
+
+func TestDo_errorNotFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"error": "The requested user could not be found"}`, http.StatusNotFound)
+	})
+
+	_, _, err := client.Users.Get(context.Background(), "1")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Users.Get returned error %v, want ErrNotFound", err)
+	}
+
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Message != "The requested user could not be found" {
+		t.Errorf("Users.Get returned error %#v, want the ErrorResponse", err)
+	}
+}
+
+func TestDo_errorResponse(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error": "bad", "link": "l", "developer_message": "dev", "error_code": 2204}`)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	_, err := client.Do(context.Background(), req, nil)
+
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Fatalf("Do returned %T, want *ErrorResponse", err)
+	}
+
+	if errResp.Message != "bad" || errResp.Link != "l" || errResp.DeveloperMessage != "dev" || errResp.ErrorCode != 2204 {
+		t.Errorf("Do returned %+v", errResp)
+	}
+}
+
 func TestCheckError_statusFail(t *testing.T) {
 	res := &http.Response{
 		Request:    &http.Request{},
@@ -414,3 +892,271 @@ func TestAddOptions(t *testing.T) {
 		t.Errorf("addOptions returned url: %v, get %v", opURL, "api?a=1&b=2")
 	}
 }
+
+func TestAddOptions_extra(t *testing.T) {
+	opt := &ListVideoOptions{
+		Filter: "embeddable",
+		ListOptions: ListOptions{
+			Page:  2,
+			Extra: url.Values{"filter_new": {"1"}, "filter": {"other"}, "page": {"5"}},
+		},
+	}
+
+	got, err := addOptions("videos", opt)
+	if err != nil {
+		t.Errorf("addOptions returned unexpected error: %v", err)
+	}
+
+	if want := "videos?filter=embeddable&filter_new=1&page=2"; got != want {
+		t.Errorf("addOptions returned url %v, want %v", got, want)
+	}
+}
+
+func TestAddOptions_fields(t *testing.T) {
+	opt := &ListOptions{Page: 1, Fields: []string{"uri", "name"}}
+	opURL, err := addOptions("api", opt)
+	if err != nil {
+		t.Errorf("addOptions returned unexpected error: %v", err)
+	}
+
+	if want := "api?fields=uri%2Cname&page=1"; opURL != want {
+		t.Errorf("addOptions returned url: %v, want %v", opURL, want)
+	}
+}
+
+func TestAddOptions_slices(t *testing.T) {
+	opt := &ListVideoOptions{
+		Filter:              "content_rating",
+		FilterContentRating: []string{"safe", "unrated"},
+		ListOptions:         ListOptions{Fields: []string{"uri", "name"}},
+	}
+	opURL, err := addOptions("api", opt)
+	if err != nil {
+		t.Fatalf("addOptions returned unexpected error: %v", err)
+	}
+
+	u, _ := url.Parse(opURL)
+	want := url.Values{
+		"filter":                {"content_rating"},
+		"filter_content_rating": {"safe,unrated"},
+		"fields":                {"uri,name"},
+	}
+	if got := u.Query(); !reflect.DeepEqual(got, want) {
+		t.Errorf("addOptions returned query %v, want %v", got, want)
+	}
+}
+
+func TestSanitizeID(t *testing.T) {
+	for _, id := range []string{"", "1", "user42", "john.doe", "a_b-c~d"} {
+		if err := sanitizeID(id); err != nil {
+			t.Errorf("sanitizeID(%q) returned unexpected error: %v", id, err)
+		}
+	}
+
+	for _, id := range []string{"..", ".", "../me", "1/2", "1%2F2", "1?fields=name", "1#a", "a b", "é"} {
+		if err := sanitizeID(id); err == nil {
+			t.Errorf("sanitizeID(%q) expected error to be returned", id)
+		}
+	}
+}
+
+func TestUserPath(t *testing.T) {
+	tests := []struct {
+		uid    string
+		format string
+		a      []interface{}
+		want   string
+	}{
+		{"", "", nil, "me"},
+		{"1", "", nil, "users/1"},
+		{"", "albums/%s", []interface{}{"ab"}, "me/albums/ab"},
+		{"1", "albums/%s/videos/%d", []interface{}{"ab", 2}, "users/1/albums/ab/videos/2"},
+	}
+
+	for _, tt := range tests {
+		got, err := userPath(tt.uid, tt.format, tt.a...)
+		if err != nil {
+			t.Errorf("userPath(%q, %q) returned unexpected error: %v", tt.uid, tt.format, err)
+		}
+		if got != tt.want {
+			t.Errorf("userPath(%q, %q) returned %q, want %q", tt.uid, tt.format, got, tt.want)
+		}
+	}
+
+	if _, err := userPath("../me", "albums"); err == nil {
+		t.Error("userPath expected error to be returned")
+	}
+}
+
+func TestDo_withFields(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/users/1", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{"fields": "uri,name"})
+		fmt.Fprint(w, `{"name": "Test"}`)
+	})
+
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{"fields": "name"})
+		fmt.Fprint(w, `{"data": []}`)
+	})
+
+	ctx := WithFields(context.Background(), "uri", "name")
+
+	if _, _, err := client.Users.Get(ctx, "1"); err != nil {
+		t.Errorf("Users.Get returned unexpected error: %v", err)
+	}
+
+	// Explicit options take precedence over the context.
+	opt := &ListUserOptions{ListOptions: ListOptions{Fields: []string{"name"}}}
+	if _, _, err := client.Users.Search(ctx, opt); err != nil {
+		t.Errorf("Users.Search returned unexpected error: %v", err)
+	}
+}
+
+func TestDo_withAPIVersion(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Accept", "application/vnd.vimeo.*+json;version=3.2")
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	_, err := client.Do(WithAPIVersion(context.Background(), "3.2"), req, nil)
+	if err != nil {
+		t.Fatalf("Do returned unexpected error: %v", err)
+	}
+
+	if got, want := req.Header.Get("Accept"), "application/vnd.vimeo.*+json;version=3.4"; got != want {
+		t.Errorf("Do modified the request Accept header to %v, want %v", got, want)
+	}
+}
+
+func TestDo_withContextOtherHost(t *testing.T) {
+	setup()
+	defer teardown()
+
+	upload := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testFormValues(t, r, values{})
+		testHeader(t, r, "Accept", "application/octet-stream")
+	}))
+	defer upload.Close()
+
+	ctx := WithAPIVersion(WithFields(context.Background(), "uri"), "3.2")
+
+	req, _ := http.NewRequest("PATCH", upload.URL+"/upload", nil)
+	req.Header.Set("Accept", "application/octet-stream")
+	if _, err := client.Do(ctx, req, nil); err != nil {
+		t.Fatalf("Do returned unexpected error: %v", err)
+	}
+}
+
+func TestNewRequest_apiVersion(t *testing.T) {
+	c := NewClient(nil)
+
+	c.APIVersion = "3.1"
+	req, _ := c.NewRequest("GET", "/", nil)
+	if got, want := req.Header.Get("Accept"), "application/vnd.vimeo.*+json;version=3.1"; got != want {
+		t.Errorf("NewRequest header Accept is %v, want %v", got, want)
+	}
+
+	c.APIVersion = ""
+	req, _ = c.NewRequest("GET", "/", nil)
+	if got, want := req.Header.Get("Accept"), "application/vnd.vimeo.*+json"; got != want {
+		t.Errorf("NewRequest header Accept is %v, want %v", got, want)
+	}
+}
+
+func TestDo_onResponse(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer token" {
+			t.Errorf("Authorization header is %q, want %q", got, "Bearer token")
+		}
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	var logs []*RequestLog
+	client.OnResponse = func(l *RequestLog) { logs = append(logs, l) }
+	client.SetToken(&Token{AccessToken: "token"})
+
+	req, _ := client.NewRequest("GET", "/?client_secret=s", nil)
+	client.Do(context.Background(), req, nil)
+
+	if len(logs) != 1 {
+		t.Fatalf("OnResponse called %d times, want 1", len(logs))
+	}
+
+	l := logs[0]
+	if l.Method != "GET" || l.Status != http.StatusBadRequest || l.Err != nil {
+		t.Errorf("OnResponse got %+v", l)
+	}
+	if got := l.URL.Query().Get("client_secret"); got != "REDACTED" {
+		t.Errorf("OnResponse URL client_secret is %q, want REDACTED", got)
+	}
+	if got := l.Header.Get("Authorization"); got != "REDACTED" {
+		t.Errorf("OnResponse Authorization header is %q, want REDACTED", got)
+	}
+	if got := req.URL.Query().Get("client_secret"); got != "s" {
+		t.Errorf("OnResponse modified the request URL: client_secret is %q", got)
+	}
+}
+
+// userListBody returns a list response of n users.
+func userListBody(n int) []byte {
+	users := make([]*User, n)
+	for i := range users {
+		users[i] = &User{
+			URI:      fmt.Sprintf("/users/%d", i),
+			Name:     fmt.Sprintf("User %d", i),
+			Link:     fmt.Sprintf("https://vimeo.com/user%d", i),
+			Bio:      strings.Repeat("bio ", 50),
+			Pictures: &Pictures{Sizes: []*PictureSize{{Width: 100, Height: 75, Link: "https://i.vimeocdn.com/portrait/1_100x75.jpg"}}},
+		}
+	}
+
+	body, _ := json.Marshal(&dataListUser{Data: users, pagination: pagination{Total: n, Page: 1, PerPage: n}})
+	return body
+}
+
+// BenchmarkDo_listUser compares the allocations of Do, which decodes from
+// the response body, with reading the whole body before unmarshalling it.
+func BenchmarkDo_listUser(b *testing.B) {
+	body := userListBody(100)
+
+	c := NewClient(&http.Client{Transport: RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(bytes.NewReader(body)),
+			Request:    req,
+		}, nil
+	})})
+
+	b.Run("decoder", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			req, _ := c.NewRequest("GET", "users", nil)
+			if _, err := c.Do(context.Background(), req, &dataListUser{}); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("readall", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			data, err := ioutil.ReadAll(bytes.NewReader(body))
+			if err != nil {
+				b.Fatal(err)
+			}
+			if err := json.Unmarshal(data, &dataListUser{}); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
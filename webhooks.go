@@ -0,0 +1,152 @@
+package vimeo
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// WebhooksService handles communication with the webhooks related
+// methods of the Vimeo API.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/webhooks
+type WebhooksService service
+
+type dataListWebhook struct {
+	Data []*Webhook `json:"data,omitempty"`
+	pagination
+}
+
+// Webhook represents a webhook subscription.
+type Webhook struct {
+	URI         string    `json:"uri,omitempty"`
+	CallbackURL string    `json:"callback_url,omitempty"`
+	Events      []string  `json:"event_types,omitempty"`
+	Active      bool      `json:"active"`
+	CreatedTime Timestamp `json:"created_time,omitempty"`
+}
+
+// WebhookRequest represents a request to create a webhook.
+type WebhookRequest struct {
+	CallbackURL string   `json:"callback_url"`
+	Events      []string `json:"event_types"`
+}
+
+// ListWebhookOptions specifies the optional parameters to the
+// WebhooksService.List method.
+type ListWebhookOptions struct {
+	ListOptions
+}
+
+// List lists the webhooks of the authenticated user.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/me/webhooks
+func (s *WebhooksService) List(ctx context.Context, opt *ListWebhookOptions) ([]*Webhook, *Response, error) {
+	u, err := addOptions("me/webhooks", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	webhooks := &dataListWebhook{}
+
+	resp, err := s.client.Do(ctx, req, webhooks)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.setPaging(webhooks)
+
+	return webhooks.Data, resp, err
+}
+
+// Create registers a new webhook for the events of the authenticated user.
+// Vimeo verifies the callback URL first, see HandleVerification.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/me/webhooks
+func (s *WebhooksService) Create(ctx context.Context, r *WebhookRequest) (*Webhook, *Response, error) {
+	req, err := s.client.NewRequest("POST", "me/webhooks", r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	webhook := &Webhook{}
+
+	resp, err := s.client.Do(ctx, req, webhook)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return webhook, resp, nil
+}
+
+// Get specific webhook by ID.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/me/webhooks/%7Bwebhook_id%7D
+func (s *WebhooksService) Get(ctx context.Context, id string) (*Webhook, *Response, error) {
+	req, err := s.client.NewRequest("GET", fmt.Sprintf("me/webhooks/%s", id), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	webhook := &Webhook{}
+
+	resp, err := s.client.Do(ctx, req, webhook)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return webhook, resp, err
+}
+
+// Delete specific webhook by ID.
+//
+// Vimeo API docs: https://developer.vimeo.com/api/playground/me/webhooks/%7Bwebhook_id%7D
+func (s *WebhooksService) Delete(ctx context.Context, id string) (*Response, error) {
+	req, err := s.client.NewRequest("DELETE", fmt.Sprintf("me/webhooks/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// VerifyWebhook reports whether signature, the value of the X-Vimeo-Signature
+// header, is the HMAC-SHA256 of payload keyed with the webhook secret.
+// The signature is hex encoded, optionally prefixed with "sha256=".
+func VerifyWebhook(secret string, signature string, payload []byte) bool {
+	got, err := hex.DecodeString(strings.TrimPrefix(signature, "sha256="))
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+// HandleVerification answers the verification challenge sent by Vimeo to
+// the callback URL of a new webhook. The challenge is echoed back as plain
+// text, requests without challenge are rejected with 400 Bad Request.
+// Call it from the handler of the callback URL before processing events.
+func (s *WebhooksService) HandleVerification(w http.ResponseWriter, r *http.Request) {
+	challenge := r.URL.Query().Get("challenge")
+	if challenge == "" {
+		http.Error(w, "missing challenge", http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	io.WriteString(w, challenge)
+}
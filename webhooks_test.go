@@ -0,0 +1,152 @@
+package vimeo
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestWebhooksService_HandleVerification(t *testing.T) {
+	r := httptest.NewRequest("GET", "/callback?challenge=abc", nil)
+	w := httptest.NewRecorder()
+
+	NewClient(nil).Webhooks.HandleVerification(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Webhooks.HandleVerification returned status %d, want %d", w.Code, http.StatusOK)
+	}
+	if got := w.Body.String(); got != "abc" {
+		t.Errorf("Webhooks.HandleVerification returned %q, want %q", got, "abc")
+	}
+	if got := w.Header().Get("Content-Type"); got != "text/plain; charset=utf-8" {
+		t.Errorf("Webhooks.HandleVerification Content-Type is %q", got)
+	}
+}
+
+func TestWebhooksService_HandleVerification_missingChallenge(t *testing.T) {
+	r := httptest.NewRequest("GET", "/callback", nil)
+	w := httptest.NewRecorder()
+
+	NewClient(nil).Webhooks.HandleVerification(w, r)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Webhooks.HandleVerification returned status %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestWebhooksService_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/webhooks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"data": [{"uri": "/me/webhooks/1", "active": true}]}`)
+	})
+
+	webhooks, _, err := client.Webhooks.List(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Webhooks.List returned unexpected error: %v", err)
+	}
+
+	want := []*Webhook{{URI: "/me/webhooks/1", Active: true}}
+	if !reflect.DeepEqual(webhooks, want) {
+		t.Errorf("Webhooks.List returned %+v, want %+v", webhooks, want)
+	}
+}
+
+func TestWebhooksService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := &WebhookRequest{
+		CallbackURL: "https://example.com/callback",
+		Events:      []string{"video.transcode.complete"},
+	}
+
+	mux.HandleFunc("/me/webhooks", func(w http.ResponseWriter, r *http.Request) {
+		v := &WebhookRequest{}
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, input) {
+			t.Errorf("Webhooks.Create body is %+v, want %+v", v, input)
+		}
+
+		fmt.Fprint(w, `{"uri": "/me/webhooks/1", "callback_url": "https://example.com/callback", "event_types": ["video.transcode.complete"]}`)
+	})
+
+	webhook, _, err := client.Webhooks.Create(context.Background(), input)
+	if err != nil {
+		t.Errorf("Webhooks.Create returned unexpected error: %v", err)
+	}
+
+	want := &Webhook{URI: "/me/webhooks/1", CallbackURL: "https://example.com/callback", Events: []string{"video.transcode.complete"}}
+	if !reflect.DeepEqual(webhook, want) {
+		t.Errorf("Webhooks.Create returned %+v, want %+v", webhook, want)
+	}
+}
+
+func TestWebhooksService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/webhooks/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"uri": "/me/webhooks/1"}`)
+	})
+
+	webhook, _, err := client.Webhooks.Get(context.Background(), "1")
+	if err != nil {
+		t.Errorf("Webhooks.Get returned unexpected error: %v", err)
+	}
+
+	want := &Webhook{URI: "/me/webhooks/1"}
+	if !reflect.DeepEqual(webhook, want) {
+		t.Errorf("Webhooks.Get returned %+v, want %+v", webhook, want)
+	}
+}
+
+func TestWebhooksService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/me/webhooks/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Webhooks.Delete(context.Background(), "1")
+	if err != nil {
+		t.Errorf("Webhooks.Delete returned unexpected error: %v", err)
+	}
+}
+
+func TestVerifyWebhook(t *testing.T) {
+	payload := []byte(`{"event": "video.transcode.complete"}`)
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(payload)
+	signature := hex.EncodeToString(mac.Sum(nil))
+
+	tests := []struct {
+		secret, signature string
+		want              bool
+	}{
+		{"secret", signature, true},
+		{"secret", "sha256=" + signature, true},
+		{"other", signature, false},
+		{"secret", "not hex", false},
+		{"secret", "", false},
+	}
+
+	for _, tt := range tests {
+		if got := VerifyWebhook(tt.secret, tt.signature, payload); got != tt.want {
+			t.Errorf("VerifyWebhook(%q, %q) returned %v, want %v", tt.secret, tt.signature, got, tt.want)
+		}
+	}
+}